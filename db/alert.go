@@ -0,0 +1,31 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// Alert in database
+type Alert struct {
+	ID           uint `gorm:"primaryKey"`
+	UserID       uint
+	User         User
+	SecurityUUID uuid.UUID
+	Security     Security `gorm:"foreignKey:security_uuid"`
+	MarketCode   *string
+	Type         model.AlertType
+	Threshold    *decimal.Decimal
+	LastEventID  uint
+	CreatedAt    time.Time
+	TriggeredAt  *time.Time
+
+	NotificationError *string
+}
+
+// TableName defines name of table in database
+func (Alert) TableName() string {
+	return "alerts"
+}
@@ -33,3 +33,12 @@ func (d *DecimalString) NullDecimal() decimal.NullDecimal {
 	}
 	return decimal.NewNullDecimal(dec)
 }
+
+// DecimalStringFromDecimal converts optional decimal into DecimalString
+func DecimalStringFromDecimal(d *decimal.Decimal) *DecimalString {
+	if d == nil {
+		return nil
+	}
+	ds := DecimalString(d.String())
+	return &ds
+}
@@ -2,8 +2,15 @@ package db
 
 // Market in database
 type Market struct {
-	Code string `gorm:"primaryKey"`
-	Name string
+	Code         string `gorm:"primaryKey"`
+	Name         string
+	Mic          *string
+	CountryCode  *string
+	Timezone     *string
+	CurrencyCode *string
+	OpenTime     *string
+	CloseTime    *string
+	Calendar     *string
 }
 
 // TableName defines name of table in database
@@ -0,0 +1,27 @@
+-- Create Enums
+CREATE TYPE "alert_type" AS ENUM ('priceAbove', 'priceBelow', 'percentChange', 'dividend');
+
+-- Create Tables
+CREATE TABLE "alerts" (
+  "id" SERIAL NOT NULL,
+  "user_id" INTEGER NOT NULL,
+  "security_uuid" UUID NOT NULL,
+  "market_code" TEXT,
+  "type" "alert_type" NOT NULL,
+  "threshold" DECIMAL(16,8),
+  "email" TEXT NOT NULL,
+  "last_event_id" INTEGER NOT NULL DEFAULT 0,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "triggered_at" TIMESTAMPTZ,
+
+  PRIMARY KEY ("id")
+);
+
+-- Create Indexes
+CREATE INDEX "alerts.user_id_index" ON "alerts"("user_id");
+CREATE INDEX "alerts.pending_index" ON "alerts"("security_uuid") WHERE "triggered_at" IS NULL;
+
+-- Add Foreign Keys
+ALTER TABLE "alerts" ADD FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE "alerts" ADD FOREIGN KEY ("security_uuid") REFERENCES "securities"("uuid") ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE "alerts" ADD FOREIGN KEY ("market_code") REFERENCES "markets"("code") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+-- Add columns
+ALTER TABLE markets ADD COLUMN calendar TEXT;
@@ -0,0 +1,11 @@
+-- Add columns
+ALTER TABLE markets
+  ADD COLUMN mic TEXT,
+  ADD COLUMN country_code CHAR(2),
+  ADD COLUMN timezone TEXT,
+  ADD COLUMN currency_code CHAR(3),
+  ADD COLUMN open_time TEXT,
+  ADD COLUMN close_time TEXT;
+
+-- Add Foreign Keys
+ALTER TABLE "markets" ADD FOREIGN KEY ("currency_code") REFERENCES "currencies"("code") ON DELETE SET NULL ON UPDATE CASCADE;
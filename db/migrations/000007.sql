@@ -0,0 +1,5 @@
+-- Add columns
+ALTER TABLE securities_markets
+  ADD COLUMN price_update_at TIMESTAMPTZ,
+  ADD COLUMN price_update_error TEXT,
+  ADD COLUMN price_update_failures INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- Add columns
+ALTER TABLE securities_markets_prices
+  ADD COLUMN open DECIMAL(10,4),
+  ADD COLUMN high DECIMAL(10,4),
+  ADD COLUMN low DECIMAL(10,4),
+  ADD COLUMN volume BIGINT;
@@ -0,0 +1,26 @@
+-- Create Enums
+CREATE TYPE "price_finding_type" AS ENUM ('jump', 'nonPositive', 'stale', 'plateau');
+
+-- Create Tables
+CREATE TABLE "price_findings" (
+  "id" SERIAL NOT NULL,
+  "security_market_id" INTEGER NOT NULL,
+  "type" "price_finding_type" NOT NULL,
+  "date" DATE NOT NULL,
+  "details" TEXT NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "detected_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "acknowledged_at" TIMESTAMPTZ,
+  "acknowledged_by_user_id" INTEGER,
+  "note" TEXT,
+
+  PRIMARY KEY ("id")
+);
+
+-- Create Indexes
+CREATE UNIQUE INDEX "price_findings.security_market_id_type_date_unique" ON "price_findings"("security_market_id", "type", "date");
+CREATE INDEX "price_findings.open_index" ON "price_findings"("type") WHERE "acknowledged_at" IS NULL;
+
+-- Add Foreign Keys
+ALTER TABLE "price_findings" ADD FOREIGN KEY ("security_market_id") REFERENCES "securities_markets"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE "price_findings" ADD FOREIGN KEY ("acknowledged_by_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,19 @@
+-- Create Tables
+CREATE TABLE "securities_history" (
+  "id" SERIAL NOT NULL,
+  "security_uuid" UUID NOT NULL,
+  "version" INTEGER NOT NULL,
+  "action" TEXT NOT NULL,
+  "previous" JSONB,
+  "user_id" INTEGER,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY ("id")
+);
+
+-- Create Indexes
+-- History is kept after security is deleted, thus no foreign key on security_uuid
+CREATE UNIQUE INDEX "securities_history.security_uuid_version_unique" ON "securities_history"("security_uuid", "version");
+
+-- Add Foreign Keys
+ALTER TABLE "securities_history" ADD FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
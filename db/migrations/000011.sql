@@ -0,0 +1,14 @@
+-- Create Tables
+CREATE TABLE "securities_redirects" (
+  "old_uuid" UUID NOT NULL,
+  "security_uuid" UUID NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY ("old_uuid")
+);
+
+-- Create Indexes
+CREATE INDEX "securities_redirects.security_uuid_index" ON "securities_redirects"("security_uuid");
+
+-- Add Foreign Keys
+ALTER TABLE "securities_redirects" ADD FOREIGN KEY ("security_uuid") REFERENCES "securities"("uuid") ON DELETE CASCADE ON UPDATE CASCADE;
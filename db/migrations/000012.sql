@@ -0,0 +1,34 @@
+-- Create Tables
+CREATE TABLE "securities_identifiers" (
+  "id" SERIAL NOT NULL,
+  "security_uuid" UUID NOT NULL,
+  "scheme" VARCHAR(10) NOT NULL,
+  "mic" VARCHAR(4) NOT NULL DEFAULT E'',
+  "value" VARCHAR(50) NOT NULL,
+
+  PRIMARY KEY ("id")
+);
+
+-- Create Indexes
+CREATE UNIQUE INDEX "securities_identifiers.security_uuid_scheme_mic_value_unique" ON "securities_identifiers"("security_uuid", "scheme", "mic", "value");
+CREATE INDEX "securities_identifiers.value_index" ON "securities_identifiers"("value");
+
+-- Add Foreign Keys
+ALTER TABLE "securities_identifiers" ADD FOREIGN KEY ("security_uuid") REFERENCES "securities"("uuid") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- Migrate Symbols
+INSERT INTO "securities_identifiers" ("security_uuid", "scheme", "mic", "value")
+SELECT "uuid", 'ticker', s."mic", UPPER(TRIM(s."value"))
+FROM "securities",
+LATERAL (VALUES ('XFRA', "symbol_xfra"), ('XNAS', "symbol_xnas"), ('XNYS', "symbol_xnys")) AS s("mic", "value")
+WHERE TRIM(s."value") <> '';
+
+UPDATE "securities_history" SET "previous" = ("previous" - 'symbolXfra' - 'symbolXnas' - 'symbolXnys') || jsonb_build_object('identifiers', (
+  SELECT COALESCE(jsonb_agg(jsonb_build_object('scheme', 'ticker', 'mic', s."mic", 'value', UPPER(TRIM(s."value"))) ORDER BY s."mic"), '[]'::jsonb)
+  FROM (VALUES ('XFRA', "previous"->>'symbolXfra'), ('XNAS', "previous"->>'symbolXnas'), ('XNYS', "previous"->>'symbolXnys')) AS s("mic", "value")
+  WHERE TRIM(s."value") <> ''))
+WHERE jsonb_typeof("previous") = 'object';
+
+ALTER TABLE "securities" DROP COLUMN "symbol_xfra";
+ALTER TABLE "securities" DROP COLUMN "symbol_xnas";
+ALTER TABLE "securities" DROP COLUMN "symbol_xnys";
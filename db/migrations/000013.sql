@@ -0,0 +1,20 @@
+-- Create Extension
+CREATE EXTENSION IF NOT EXISTS unaccent ;
+
+-- Create Functions
+-- unaccent is not immutable since its dictionary can change, which is required for indexes
+CREATE OR REPLACE FUNCTION "immutable_unaccent"(text) RETURNS text
+  AS $$ SELECT public.unaccent('public.unaccent'::regdictionary, $1) $$
+  LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- Drop Indexes
+DROP INDEX "securities_isin";
+DROP INDEX "securities_wkn";
+DROP INDEX "securities_identifiers.value_index";
+
+-- Create Indexes
+-- text_pattern_ops supports prefix matching (LIKE 'prefix%') besides equality
+CREATE INDEX "securities.isin_pattern_index" ON "securities"("isin" text_pattern_ops);
+CREATE INDEX "securities.wkn_pattern_index" ON "securities"("wkn" text_pattern_ops);
+CREATE INDEX "securities_identifiers.value_pattern_index" ON "securities_identifiers"("value" text_pattern_ops);
+CREATE INDEX "securities.name_unaccent_trigram_index" ON "securities" USING gin(LOWER(immutable_unaccent("name")) gin_trgm_ops);
@@ -0,0 +1,24 @@
+-- Create Tables
+CREATE TABLE "securities_aliases" (
+  "id" SERIAL NOT NULL,
+  "security_uuid" UUID NOT NULL,
+  "kind" VARCHAR(20) NOT NULL,
+  "name" TEXT NOT NULL,
+
+  PRIMARY KEY ("id")
+);
+
+-- Create Indexes
+CREATE UNIQUE INDEX "securities_aliases.security_uuid_name_unique" ON "securities_aliases"("security_uuid", LOWER("name"));
+CREATE INDEX "securities_aliases.name_unaccent_trigram_index" ON "securities_aliases" USING gin(LOWER(immutable_unaccent("name")) gin_trgm_ops);
+-- Full-text search matches words of names and aliases, 'simple' configuration
+-- does not stem since names are in many languages
+CREATE INDEX "securities_aliases.name_fulltext_index" ON "securities_aliases" USING gin(to_tsvector('simple', immutable_unaccent("name")));
+CREATE INDEX "securities.name_fulltext_index" ON "securities" USING gin(to_tsvector('simple', immutable_unaccent("name")));
+
+-- Add Foreign Keys
+ALTER TABLE "securities_aliases" ADD FOREIGN KEY ("security_uuid") REFERENCES "securities"("uuid") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- Securities had no aliases before
+UPDATE "securities_history" SET "previous" = "previous" || jsonb_build_object('aliases', '[]'::jsonb)
+WHERE jsonb_typeof("previous") = 'object';
@@ -0,0 +1,21 @@
+-- Create Tables
+CREATE TABLE "securities_holdings" (
+  "id" SERIAL NOT NULL,
+  "security_uuid" UUID NOT NULL,
+  "as_of_date" DATE NOT NULL,
+  "constituent_uuid" UUID,
+  "name" TEXT,
+  "isin" VARCHAR(12),
+  "weight" DECIMAL(7,4) NOT NULL,
+
+  PRIMARY KEY ("id")
+);
+
+-- Create Indexes
+CREATE INDEX "securities_holdings.security_uuid_index" ON "securities_holdings"("security_uuid");
+CREATE INDEX "securities_holdings.constituent_uuid_index" ON "securities_holdings"("constituent_uuid");
+
+-- Add Foreign Keys
+ALTER TABLE "securities_holdings" ADD FOREIGN KEY ("security_uuid") REFERENCES "securities"("uuid") ON DELETE CASCADE ON UPDATE CASCADE;
+-- Constituents remain listed by name and ISIN if their security is deleted
+ALTER TABLE "securities_holdings" ADD FOREIGN KEY ("constituent_uuid") REFERENCES "securities"("uuid") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,11 @@
+-- Add columns
+-- updated_at is maintained by the application and used as validator of HTTP
+-- responses, changes of associated rows (e.g. events) update it as well
+ALTER TABLE securities
+  ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE securities_markets
+  ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE currencies
+  ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE exchangerates
+  ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,14 @@
+-- Add columns
+-- Notifications are only sent to verified email addresses of users
+ALTER TABLE users
+  ADD COLUMN email TEXT,
+  ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT false,
+  ADD COLUMN email_verification_token TEXT,
+  ADD COLUMN email_verification_sent_at TIMESTAMPTZ;
+ALTER TABLE alerts
+  ADD COLUMN notification_error TEXT;
+
+-- Drop columns
+-- Alerts are sent to email address of their user instead of arbitrary addresses
+ALTER TABLE alerts
+  DROP COLUMN email;
@@ -0,0 +1,14 @@
+-- Migrate Identifiers
+-- ISIN and WKN identifiers are further codes besides attributes isin and wkn of securities
+UPDATE "securities" s SET "isin" = i."value"
+FROM (SELECT "security_uuid", MIN("value") AS "value" FROM "securities_identifiers" WHERE "scheme" = 'isin' AND LENGTH("value") = 12 GROUP BY "security_uuid") i
+WHERE i."security_uuid" = s."uuid" AND s."isin" IS NULL;
+
+UPDATE "securities" s SET "wkn" = i."value"
+FROM (SELECT "security_uuid", MIN("value") AS "value" FROM "securities_identifiers" WHERE "scheme" = 'wkn' AND LENGTH("value") = 6 GROUP BY "security_uuid") i
+WHERE i."security_uuid" = s."uuid" AND s."wkn" IS NULL;
+
+DELETE FROM "securities_identifiers" i
+USING "securities" s
+WHERE i."security_uuid" = s."uuid"
+  AND ((i."scheme" = 'isin' AND i."value" = UPPER(s."isin")) OR (i."scheme" = 'wkn' AND i."value" = UPPER(s."wkn")));
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"time"
+
+	"github.com/portfolio-report/pr-api/graph/model"
+)
+
+// PriceFinding in database
+type PriceFinding struct {
+	ID                   uint `gorm:"primaryKey"`
+	SecurityMarketID     uint
+	Type                 model.PriceFindingType
+	Date                 model.Date
+	Details              string
+	CreatedAt            time.Time
+	DetectedAt           time.Time
+	AcknowledgedAt       *time.Time
+	AcknowledgedByUserID *uint
+	Note                 *string
+}
+
+// TableName defines name of table in database
+func (PriceFinding) TableName() string {
+	return "price_findings"
+}
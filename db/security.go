@@ -11,15 +11,14 @@ type Security struct {
 	Name               *string
 	Isin               *string
 	Wkn                *string
-	SymbolXfra         *string
-	SymbolXnas         *string
-	SymbolXnys         *string
 	SecurityType       *string
-	Extras             datatypes.JSON     `gorm:"default:'{}'"`
-	SecurityMarkets    []SecurityMarket   `gorm:"foreignKey:security_uuid;references:uuid"`
-	Events             []Event            `gorm:"foreignKey:security_uuid;references:uuid"`
-	SecurityTaxonomies []SecurityTaxonomy `gorm:"foreignKey:security_uuid;references:uuid"`
-	Tags               []Tag              `gorm:"many2many:securities_tags"`
+	Extras             datatypes.JSON       `gorm:"default:'{}'"`
+	Identifiers        []SecurityIdentifier `gorm:"foreignKey:security_uuid;references:uuid"`
+	Aliases            []SecurityAlias      `gorm:"foreignKey:security_uuid;references:uuid"`
+	SecurityMarkets    []SecurityMarket     `gorm:"foreignKey:security_uuid;references:uuid"`
+	Events             []Event              `gorm:"foreignKey:security_uuid;references:uuid"`
+	SecurityTaxonomies []SecurityTaxonomy   `gorm:"foreignKey:security_uuid;references:uuid"`
+	Tags               []Tag                `gorm:"many2many:securities_tags"`
 }
 
 // TableName defines name of table in database
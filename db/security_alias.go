@@ -0,0 +1,26 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SecurityAlias is alternative name of security in database
+type SecurityAlias struct {
+	ID           uint `gorm:"primaryKey"`
+	SecurityUUID uuid.UUID
+	Kind         string
+	Name         string
+}
+
+// TableName defines name of table in database
+func (SecurityAlias) TableName() string {
+	return "securities_aliases"
+}
+
+// PreloadAliases is scope loading aliases of securities ordered by kind and name
+func PreloadAliases(tx *gorm.DB) *gorm.DB {
+	return tx.Preload("Aliases", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order(`kind COLLATE "C", name COLLATE "C"`)
+	})
+}
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"gorm.io/datatypes"
+)
+
+// SecurityHistory in database, holds state of security before change
+type SecurityHistory struct {
+	ID           uint `gorm:"primaryKey"`
+	SecurityUUID uuid.UUID
+	Version      int
+	Action       model.SecurityChangeAction
+	Previous     datatypes.JSON
+	UserID       *uint
+	CreatedAt    time.Time
+}
+
+// TableName defines name of table in database
+func (SecurityHistory) TableName() string {
+	return "securities_history"
+}
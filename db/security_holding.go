@@ -0,0 +1,24 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// SecurityHolding is constituent of fund in database, constituent is
+// nil if it is not in master data. All holdings of fund have same date.
+type SecurityHolding struct {
+	ID              uint `gorm:"primaryKey"`
+	SecurityUUID    uuid.UUID
+	AsOfDate        model.Date
+	ConstituentUUID *uuid.UUID
+	Name            *string
+	Isin            *string
+	Weight          decimal.Decimal
+}
+
+// TableName defines name of table in database
+func (SecurityHolding) TableName() string {
+	return "securities_holdings"
+}
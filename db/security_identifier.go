@@ -0,0 +1,39 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SecurityIdentifier in database, MIC is empty for schemes other than ticker
+type SecurityIdentifier struct {
+	ID           uint `gorm:"primaryKey"`
+	SecurityUUID uuid.UUID
+	Scheme       string
+	Mic          string
+	Value        string
+}
+
+// TableName defines name of table in database
+func (SecurityIdentifier) TableName() string {
+	return "securities_identifiers"
+}
+
+// Ticker returns ticker symbol of security at market identified by MIC,
+// identifiers must be loaded
+func (s Security) Ticker(mic string) *string {
+	for _, i := range s.Identifiers {
+		if i.Scheme == "ticker" && i.Mic == mic {
+			value := i.Value
+			return &value
+		}
+	}
+	return nil
+}
+
+// PreloadIdentifiers is scope loading identifiers of securities ordered by scheme, MIC and value
+func PreloadIdentifiers(tx *gorm.DB) *gorm.DB {
+	return tx.Preload("Identifiers", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order(`scheme COLLATE "C", mic COLLATE "C", value COLLATE "C"`)
+	})
+}
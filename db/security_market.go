@@ -1,6 +1,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/portfolio-report/pr-api/graph/model"
 	"gorm.io/datatypes"
 )
@@ -16,6 +18,10 @@ type SecurityMarket struct {
 	Symbol         *string
 	UpdatePrices   bool
 	Extras         datatypes.JSON `gorm:"default:'{}'"`
+
+	PriceUpdateAt       *time.Time
+	PriceUpdateError    *string
+	PriceUpdateFailures int
 }
 
 // TableName defines name of table in database
@@ -28,6 +34,10 @@ type SecurityMarketPrice struct {
 	SecurityMarketID uint       `gorm:"primaryKey;autoIncrement:false"`
 	Date             model.Date `gorm:"primaryKey"`
 	Close            DecimalString
+	Open             *DecimalString
+	High             *DecimalString
+	Low              *DecimalString
+	Volume           *int64
 }
 
 // TableName defines name of table in database
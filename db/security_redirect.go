@@ -0,0 +1,19 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SecurityRedirect in database, points UUID of merged security to remaining security
+type SecurityRedirect struct {
+	OldUUID      uuid.UUID `gorm:"primaryKey"`
+	SecurityUUID uuid.UUID
+	CreatedAt    time.Time
+}
+
+// TableName defines name of table in database
+func (SecurityRedirect) TableName() string {
+	return "securities_redirects"
+}
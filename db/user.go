@@ -14,6 +14,11 @@ type User struct {
 	CreatedAt  time.Time
 	LastSeenAt datatypes.Date
 	IsAdmin    bool
+
+	Email                   *string
+	EmailVerified           bool
+	EmailVerificationToken  *string
+	EmailVerificationSentAt *time.Time
 }
 
 // TableName defines name of table in database
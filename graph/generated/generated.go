@@ -53,6 +53,20 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	CalendarEvent struct {
+		Amount         func(childComplexity int) int
+		CurrencyCode   func(childComplexity int) int
+		Date           func(childComplexity int) int
+		ExpectedIncome func(childComplexity int) int
+		Isin           func(childComplexity int) int
+		LogoURL        func(childComplexity int) int
+		Ratio          func(childComplexity int) int
+		SecurityName   func(childComplexity int) int
+		SecurityUUID   func(childComplexity int) int
+		Shares         func(childComplexity int) int
+		Type           func(childComplexity int) int
+	}
+
 	Currency struct {
 		Code               func(childComplexity int) int
 		ExchangeratesBase  func(childComplexity int) int
@@ -67,6 +81,11 @@ type ComplexityRoot struct {
 		Type         func(childComplexity int) int
 	}
 
+	EventCalendar struct {
+		Entries    func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
 	Exchangerate struct {
 		BaseCurrencyCode  func(childComplexity int) int
 		Prices            func(childComplexity int, from *string) int
@@ -169,6 +188,7 @@ type ComplexityRoot struct {
 
 	Query struct {
 		Currencies          func(childComplexity int) int
+		EventCalendar       func(childComplexity int, from *model.Date, to *model.Date, typeArg *string, tag *string, securityType *string, portfolioID *int, limit *int, skip *int) int
 		Exchangerate        func(childComplexity int, baseCurrencyCode string, quoteCurrencyCode string) int
 		Portfolio           func(childComplexity int, id int) int
 		PortfolioAccounts   func(childComplexity int, portfolioID int) int
@@ -180,8 +200,11 @@ type ComplexityRoot struct {
 	}
 
 	Security struct {
+		Aliases            func(childComplexity int) int
 		Events             func(childComplexity int) int
+		Identifiers        func(childComplexity int) int
 		Isin               func(childComplexity int) int
+		LogoThumbURL       func(childComplexity int) int
 		LogoURL            func(childComplexity int) int
 		Name               func(childComplexity int) int
 		SecurityMarkets    func(childComplexity int) int
@@ -194,6 +217,17 @@ type ComplexityRoot struct {
 		Wkn                func(childComplexity int) int
 	}
 
+	SecurityAlias struct {
+		Kind func(childComplexity int) int
+		Name func(childComplexity int) int
+	}
+
+	SecurityIdentifier struct {
+		Mic    func(childComplexity int) int
+		Scheme func(childComplexity int) int
+		Value  func(childComplexity int) int
+	}
+
 	SecurityMarket struct {
 		CurrencyCode   func(childComplexity int) int
 		FirstPriceDate func(childComplexity int) int
@@ -228,10 +262,12 @@ type ComplexityRoot struct {
 	}
 
 	User struct {
-		ID         func(childComplexity int) int
-		IsAdmin    func(childComplexity int) int
-		LastSeenAt func(childComplexity int) int
-		Username   func(childComplexity int) int
+		Email         func(childComplexity int) int
+		EmailVerified func(childComplexity int) int
+		ID            func(childComplexity int) int
+		IsAdmin       func(childComplexity int) int
+		LastSeenAt    func(childComplexity int) int
+		Username      func(childComplexity int) int
 	}
 }
 
@@ -262,6 +298,7 @@ type QueryResolver interface {
 	PortfolioSecurities(ctx context.Context, portfolioID int) ([]*model.PortfolioSecurity, error)
 	PortfolioSecurity(ctx context.Context, portfolioID int, uuid uuid.UUID) (*model.PortfolioSecurity, error)
 	Security(ctx context.Context, uuid uuid.UUID) (*model.Security, error)
+	EventCalendar(ctx context.Context, from *model.Date, to *model.Date, typeArg *string, tag *string, securityType *string, portfolioID *int, limit *int, skip *int) (*model.EventCalendar, error)
 	Sessions(ctx context.Context) ([]*model.Session, error)
 }
 type SecurityResolver interface {
@@ -290,6 +327,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CalendarEvent.amount":
+		if e.complexity.CalendarEvent.Amount == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.Amount(childComplexity), true
+
+	case "CalendarEvent.currencyCode":
+		if e.complexity.CalendarEvent.CurrencyCode == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.CurrencyCode(childComplexity), true
+
+	case "CalendarEvent.date":
+		if e.complexity.CalendarEvent.Date == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.Date(childComplexity), true
+
+	case "CalendarEvent.expectedIncome":
+		if e.complexity.CalendarEvent.ExpectedIncome == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.ExpectedIncome(childComplexity), true
+
+	case "CalendarEvent.isin":
+		if e.complexity.CalendarEvent.Isin == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.Isin(childComplexity), true
+
+	case "CalendarEvent.logoUrl":
+		if e.complexity.CalendarEvent.LogoURL == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.LogoURL(childComplexity), true
+
+	case "CalendarEvent.ratio":
+		if e.complexity.CalendarEvent.Ratio == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.Ratio(childComplexity), true
+
+	case "CalendarEvent.securityName":
+		if e.complexity.CalendarEvent.SecurityName == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.SecurityName(childComplexity), true
+
+	case "CalendarEvent.securityUuid":
+		if e.complexity.CalendarEvent.SecurityUUID == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.SecurityUUID(childComplexity), true
+
+	case "CalendarEvent.shares":
+		if e.complexity.CalendarEvent.Shares == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.Shares(childComplexity), true
+
+	case "CalendarEvent.type":
+		if e.complexity.CalendarEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.CalendarEvent.Type(childComplexity), true
+
 	case "Currency.code":
 		if e.complexity.Currency.Code == nil {
 			break
@@ -346,6 +460,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Event.Type(childComplexity), true
 
+	case "EventCalendar.entries":
+		if e.complexity.EventCalendar.Entries == nil {
+			break
+		}
+
+		return e.complexity.EventCalendar.Entries(childComplexity), true
+
+	case "EventCalendar.totalCount":
+		if e.complexity.EventCalendar.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.EventCalendar.TotalCount(childComplexity), true
+
 	case "Exchangerate.baseCurrencyCode":
 		if e.complexity.Exchangerate.BaseCurrencyCode == nil {
 			break
@@ -893,6 +1021,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Currencies(childComplexity), true
 
+	case "Query.eventCalendar":
+		if e.complexity.Query.EventCalendar == nil {
+			break
+		}
+
+		args, err := ec.field_Query_eventCalendar_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EventCalendar(childComplexity, args["from"].(*model.Date), args["to"].(*model.Date), args["type"].(*string), args["tag"].(*string), args["securityType"].(*string), args["portfolioId"].(*int), args["limit"].(*int), args["skip"].(*int)), true
+
 	case "Query.exchangerate":
 		if e.complexity.Query.Exchangerate == nil {
 			break
@@ -979,6 +1119,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Sessions(childComplexity), true
 
+	case "Security.aliases":
+		if e.complexity.Security.Aliases == nil {
+			break
+		}
+
+		return e.complexity.Security.Aliases(childComplexity), true
+
 	case "Security.events":
 		if e.complexity.Security.Events == nil {
 			break
@@ -986,6 +1133,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Security.Events(childComplexity), true
 
+	case "Security.identifiers":
+		if e.complexity.Security.Identifiers == nil {
+			break
+		}
+
+		return e.complexity.Security.Identifiers(childComplexity), true
+
 	case "Security.isin":
 		if e.complexity.Security.Isin == nil {
 			break
@@ -993,6 +1147,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Security.Isin(childComplexity), true
 
+	case "Security.logoThumbUrl":
+		if e.complexity.Security.LogoThumbURL == nil {
+			break
+		}
+
+		return e.complexity.Security.LogoThumbURL(childComplexity), true
+
 	case "Security.logoUrl":
 		if e.complexity.Security.LogoURL == nil {
 			break
@@ -1063,6 +1224,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Security.Wkn(childComplexity), true
 
+	case "SecurityAlias.kind":
+		if e.complexity.SecurityAlias.Kind == nil {
+			break
+		}
+
+		return e.complexity.SecurityAlias.Kind(childComplexity), true
+
+	case "SecurityAlias.name":
+		if e.complexity.SecurityAlias.Name == nil {
+			break
+		}
+
+		return e.complexity.SecurityAlias.Name(childComplexity), true
+
+	case "SecurityIdentifier.mic":
+		if e.complexity.SecurityIdentifier.Mic == nil {
+			break
+		}
+
+		return e.complexity.SecurityIdentifier.Mic(childComplexity), true
+
+	case "SecurityIdentifier.scheme":
+		if e.complexity.SecurityIdentifier.Scheme == nil {
+			break
+		}
+
+		return e.complexity.SecurityIdentifier.Scheme(childComplexity), true
+
+	case "SecurityIdentifier.value":
+		if e.complexity.SecurityIdentifier.Value == nil {
+			break
+		}
+
+		return e.complexity.SecurityIdentifier.Value(childComplexity), true
+
 	case "SecurityMarket.currencyCode":
 		if e.complexity.SecurityMarket.CurrencyCode == nil {
 			break
@@ -1210,6 +1406,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Taxonomy.UUID(childComplexity), true
 
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+
+	case "User.emailVerified":
+		if e.complexity.User.EmailVerified == nil {
+			break
+		}
+
+		return e.complexity.User.EmailVerified(childComplexity), true
+
 	case "User.id":
 		if e.complexity.User.ID == nil {
 			break
@@ -1254,6 +1464,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputPortfolioSecurityPropertyInput,
 		ec.unmarshalInputPortfolioTransactionInput,
 		ec.unmarshalInputPortfolioTransactionUnitInput,
+		ec.unmarshalInputSecurityAliasInput,
+		ec.unmarshalInputSecurityIdentifierInput,
 		ec.unmarshalInputSecurityInput,
 		ec.unmarshalInputSecurityTaxonomyInput,
 		ec.unmarshalInputTaxonomyInput,
@@ -1343,6 +1555,25 @@ type Event {
   ratio: String
 }
 
+type CalendarEvent {
+  securityUuid: UUID!
+  securityName: String
+  isin: String
+  logoUrl: String
+  date: Date!
+  type: String!
+  amount: String
+  currencyCode: String
+  ratio: String
+  shares: Decimal
+  expectedIncome: Decimal
+}
+
+type EventCalendar {
+  entries: [CalendarEvent!]!
+  totalCount: Int!
+}
+
 type Exchangerate {
   baseCurrencyCode: String!
   quoteCurrencyCode: String!
@@ -1519,6 +1750,9 @@ type Security {
   symbolXnas: String
   symbolXnys: String
   logoUrl: String
+  logoThumbUrl: String
+  identifiers: [SecurityIdentifier!]!
+  aliases: [SecurityAlias!]!
 
   securityMarkets: [SecurityMarket!]!
   securityTaxonomies: [SecurityTaxonomy!]!
@@ -1534,6 +1768,30 @@ input SecurityInput {
   symbolXnas: String
   symbolXnys: String
   logoUrl: String
+  identifiers: [SecurityIdentifierInput!]
+  aliases: [SecurityAliasInput!]
+}
+
+type SecurityIdentifier {
+  scheme: String!
+  mic: String
+  value: String!
+}
+
+input SecurityIdentifierInput {
+  scheme: String!
+  mic: String
+  value: String!
+}
+
+type SecurityAlias {
+  kind: String!
+  name: String!
+}
+
+input SecurityAliasInput {
+  kind: String!
+  name: String!
 }
 
 type SecurityMarket {
@@ -1586,6 +1844,8 @@ type User {
   username: String!
   isAdmin: Boolean!
   lastSeenAt: String!
+  email: String
+  emailVerified: Boolean!
 }
 
 type Query {
@@ -1600,6 +1860,16 @@ type Query {
   portfolioSecurity(portfolioId: Int!, uuid: UUID!): PortfolioSecurity!
 
   security(uuid: UUID!): Security!
+  eventCalendar(
+    from: Date
+    to: Date
+    type: String
+    tag: String
+    securityType: String
+    portfolioId: Int
+    limit: Int = 100
+    skip: Int = 0
+  ): EventCalendar!
 
   sessions: [Session!]!
 }
@@ -1815,6 +2085,84 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_eventCalendar_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.Date
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg0, err = ec.unmarshalODate2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 *model.Date
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg1, err = ec.unmarshalODate2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["type"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["type"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["tag"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tag"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["securityType"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("securityType"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["securityType"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["portfolioId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("portfolioId"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["portfolioId"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg6
+	var arg7 *int
+	if tmp, ok := rawArgs["skip"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("skip"))
+		arg7, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["skip"] = arg7
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_exchangerate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1961,8 +2309,8 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _Currency_code(ctx context.Context, field graphql.CollectedField, obj *model.Currency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Currency_code(ctx, field)
+func (ec *executionContext) _CalendarEvent_securityUuid(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_securityUuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1975,7 +2323,7 @@ func (ec *executionContext) _Currency_code(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.SecurityUUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1987,26 +2335,26 @@ func (ec *executionContext) _Currency_code(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uuid.UUID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Currency_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_securityUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Currency",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Currency_exchangeratesBase(ctx context.Context, field graphql.CollectedField, obj *model.Currency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Currency_exchangeratesBase(ctx, field)
+func (ec *executionContext) _CalendarEvent_securityName(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_securityName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2019,46 +2367,35 @@ func (ec *executionContext) _Currency_exchangeratesBase(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExchangeratesBase, nil
+		return obj.SecurityName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Exchangerate)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNExchangerate2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangerateᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Currency_exchangeratesBase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_securityName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Currency",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "baseCurrencyCode":
-				return ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
-			case "quoteCurrencyCode":
-				return ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
-			case "prices":
-				return ec.fieldContext_Exchangerate_prices(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Exchangerate", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Currency_exchangeratesQuote(ctx context.Context, field graphql.CollectedField, obj *model.Currency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Currency_exchangeratesQuote(ctx, field)
+func (ec *executionContext) _CalendarEvent_isin(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_isin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2071,46 +2408,35 @@ func (ec *executionContext) _Currency_exchangeratesQuote(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExchangeratesQuote, nil
+		return obj.Isin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Exchangerate)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNExchangerate2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangerateᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Currency_exchangeratesQuote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_isin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Currency",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "baseCurrencyCode":
-				return ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
-			case "quoteCurrencyCode":
-				return ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
-			case "prices":
-				return ec.fieldContext_Exchangerate_prices(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Exchangerate", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_date(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_date(ctx, field)
+func (ec *executionContext) _CalendarEvent_logoUrl(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_logoUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2123,26 +2449,23 @@ func (ec *executionContext) _Event_date(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.LogoURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_logoUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2153,8 +2476,8 @@ func (ec *executionContext) fieldContext_Event_date(ctx context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_type(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_type(ctx, field)
+func (ec *executionContext) _CalendarEvent_date(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2167,7 +2490,7 @@ func (ec *executionContext) _Event_type(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2179,26 +2502,26 @@ func (ec *executionContext) _Event_type(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Date)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDate2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_amount(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_amount(ctx, field)
+func (ec *executionContext) _CalendarEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2211,23 +2534,26 @@ func (ec *executionContext) _Event_amount(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2238,8 +2564,8 @@ func (ec *executionContext) fieldContext_Event_amount(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_currencyCode(ctx, field)
+func (ec *executionContext) _CalendarEvent_amount(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_amount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2252,7 +2578,7 @@ func (ec *executionContext) _Event_currencyCode(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CurrencyCode, nil
+		return obj.Amount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2266,9 +2592,9 @@ func (ec *executionContext) _Event_currencyCode(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2279,8 +2605,8 @@ func (ec *executionContext) fieldContext_Event_currencyCode(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_ratio(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_ratio(ctx, field)
+func (ec *executionContext) _CalendarEvent_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_currencyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2293,7 +2619,7 @@ func (ec *executionContext) _Event_ratio(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ratio, nil
+		return obj.CurrencyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2307,9 +2633,9 @@ func (ec *executionContext) _Event_ratio(ctx context.Context, field graphql.Coll
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_ratio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2320,8 +2646,8 @@ func (ec *executionContext) fieldContext_Event_ratio(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Exchangerate_baseCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Exchangerate) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
+func (ec *executionContext) _CalendarEvent_ratio(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_ratio(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2334,26 +2660,23 @@ func (ec *executionContext) _Exchangerate_baseCurrencyCode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BaseCurrencyCode, nil
+		return obj.Ratio, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Exchangerate_baseCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_ratio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Exchangerate",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2364,8 +2687,8 @@ func (ec *executionContext) fieldContext_Exchangerate_baseCurrencyCode(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _Exchangerate_quoteCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Exchangerate) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
+func (ec *executionContext) _CalendarEvent_shares(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_shares(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2378,38 +2701,35 @@ func (ec *executionContext) _Exchangerate_quoteCurrencyCode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QuoteCurrencyCode, nil
+		return obj.Shares, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*decimal.Decimal)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Exchangerate_quoteCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_shares(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Exchangerate",
+		Object:     "CalendarEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Exchangerate_prices(ctx context.Context, field graphql.CollectedField, obj *model.Exchangerate) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Exchangerate_prices(ctx, field)
+func (ec *executionContext) _CalendarEvent_expectedIncome(ctx context.Context, field graphql.CollectedField, obj *model.CalendarEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CalendarEvent_expectedIncome(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2422,55 +2742,35 @@ func (ec *executionContext) _Exchangerate_prices(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Exchangerate().Prices(rctx, obj, fc.Args["from"].(*string))
+		return obj.ExpectedIncome, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ExchangeratePrice)
+	res := resTmp.(*decimal.Decimal)
 	fc.Result = res
-	return ec.marshalNExchangeratePrice2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangeratePriceᚄ(ctx, field.Selections, res)
+	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Exchangerate_prices(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CalendarEvent_expectedIncome(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Exchangerate",
+		Object:     "CalendarEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "date":
-				return ec.fieldContext_ExchangeratePrice_date(ctx, field)
-			case "value":
-				return ec.fieldContext_ExchangeratePrice_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ExchangeratePrice", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Exchangerate_prices_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExchangeratePrice_date(ctx context.Context, field graphql.CollectedField, obj *model.ExchangeratePrice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExchangeratePrice_date(ctx, field)
+func (ec *executionContext) _Currency_code(ctx context.Context, field graphql.CollectedField, obj *model.Currency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Currency_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2483,7 +2783,7 @@ func (ec *executionContext) _ExchangeratePrice_date(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2500,9 +2800,9 @@ func (ec *executionContext) _ExchangeratePrice_date(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExchangeratePrice_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Currency_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExchangeratePrice",
+		Object:     "Currency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2513,8 +2813,8 @@ func (ec *executionContext) fieldContext_ExchangeratePrice_date(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ExchangeratePrice_value(ctx context.Context, field graphql.CollectedField, obj *model.ExchangeratePrice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ExchangeratePrice_value(ctx, field)
+func (ec *executionContext) _Currency_exchangeratesBase(ctx context.Context, field graphql.CollectedField, obj *model.Currency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Currency_exchangeratesBase(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2527,7 +2827,7 @@ func (ec *executionContext) _ExchangeratePrice_value(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.ExchangeratesBase, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2539,26 +2839,34 @@ func (ec *executionContext) _ExchangeratePrice_value(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Exchangerate)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNExchangerate2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangerateᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ExchangeratePrice_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Currency_exchangeratesBase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExchangeratePrice",
+		Object:     "Currency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "baseCurrencyCode":
+				return ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
+			case "quoteCurrencyCode":
+				return ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
+			case "prices":
+				return ec.fieldContext_Exchangerate_prices(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Exchangerate", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_register(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_register(ctx, field)
+func (ec *executionContext) _Currency_exchangeratesQuote(ctx context.Context, field graphql.CollectedField, obj *model.Currency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Currency_exchangeratesQuote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2571,7 +2879,7 @@ func (ec *executionContext) _Mutation_register(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Register(rctx, fc.Args["username"].(string), fc.Args["password"].(string))
+		return obj.ExchangeratesQuote, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2583,49 +2891,34 @@ func (ec *executionContext) _Mutation_register(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Session)
+	res := resTmp.([]*model.Exchangerate)
 	fc.Result = res
-	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
+	return ec.marshalNExchangerate2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangerateᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_register(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Currency_exchangeratesQuote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Currency",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "token":
-				return ec.fieldContext_Session_token(ctx, field)
-			case "note":
-				return ec.fieldContext_Session_note(ctx, field)
-			case "user":
-				return ec.fieldContext_Session_user(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "lastActivityAt":
-				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			case "baseCurrencyCode":
+				return ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
+			case "quoteCurrencyCode":
+				return ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
+			case "prices":
+				return ec.fieldContext_Exchangerate_prices(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Exchangerate", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_register_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_login(ctx, field)
+func (ec *executionContext) _Event_date(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2638,7 +2931,7 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Login(rctx, fc.Args["username"].(string), fc.Args["password"].(string))
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2650,49 +2943,26 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Session)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Event",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_Session_token(ctx, field)
-			case "note":
-				return ec.fieldContext_Session_note(ctx, field)
-			case "user":
-				return ec.fieldContext_Session_user(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "lastActivityAt":
-				return ec.fieldContext_Session_lastActivityAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createSession(ctx, field)
+func (ec *executionContext) _Event_type(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2705,7 +2975,7 @@ func (ec *executionContext) _Mutation_createSession(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateSession(rctx, fc.Args["note"].(string))
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2717,53 +2987,30 @@ func (ec *executionContext) _Mutation_createSession(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Session)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Event",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_Session_token(ctx, field)
-			case "note":
-				return ec.fieldContext_Session_note(ctx, field)
-			case "user":
-				return ec.fieldContext_Session_user(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "lastActivityAt":
-				return ec.fieldContext_Session_lastActivityAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Mutation_deleteSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteSession(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
+	return fc, nil
+}
+
+func (ec *executionContext) _Event_amount(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2772,61 +3019,35 @@ func (ec *executionContext) _Mutation_deleteSession(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteSession(rctx, fc.Args["token"].(string))
+		return obj.Amount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Session)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Event",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_Session_token(ctx, field)
-			case "note":
-				return ec.fieldContext_Session_note(ctx, field)
-			case "user":
-				return ec.fieldContext_Session_user(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "lastActivityAt":
-				return ec.fieldContext_Session_lastActivityAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createPortfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createPortfolio(ctx, field)
+func (ec *executionContext) _Event_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_currencyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2839,63 +3060,35 @@ func (ec *executionContext) _Mutation_createPortfolio(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreatePortfolio(rctx, fc.Args["portfolio"].(model.PortfolioInput))
+		return obj.CurrencyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Portfolio)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createPortfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Event",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Portfolio_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Portfolio_name(ctx, field)
-			case "note":
-				return ec.fieldContext_Portfolio_note(ctx, field)
-			case "baseCurrencyCode":
-				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Portfolio_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createPortfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updatePortfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updatePortfolio(ctx, field)
+func (ec *executionContext) _Event_ratio(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_ratio(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2908,63 +3101,35 @@ func (ec *executionContext) _Mutation_updatePortfolio(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdatePortfolio(rctx, fc.Args["id"].(int), fc.Args["portfolio"].(model.PortfolioInput))
+		return obj.Ratio, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Portfolio)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updatePortfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_ratio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Event",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Portfolio_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Portfolio_name(ctx, field)
-			case "note":
-				return ec.fieldContext_Portfolio_note(ctx, field)
-			case "baseCurrencyCode":
-				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Portfolio_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updatePortfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deletePortfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deletePortfolio(ctx, field)
+func (ec *executionContext) _EventCalendar_entries(ctx context.Context, field graphql.CollectedField, obj *model.EventCalendar) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventCalendar_entries(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2977,7 +3142,7 @@ func (ec *executionContext) _Mutation_deletePortfolio(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeletePortfolio(rctx, fc.Args["id"].(int))
+		return obj.Entries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2989,51 +3154,50 @@ func (ec *executionContext) _Mutation_deletePortfolio(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Portfolio)
+	res := resTmp.([]*model.CalendarEvent)
 	fc.Result = res
-	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
+	return ec.marshalNCalendarEvent2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐCalendarEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deletePortfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EventCalendar_entries(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "EventCalendar",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Portfolio_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Portfolio_name(ctx, field)
-			case "note":
-				return ec.fieldContext_Portfolio_note(ctx, field)
-			case "baseCurrencyCode":
-				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Portfolio_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
+			case "securityUuid":
+				return ec.fieldContext_CalendarEvent_securityUuid(ctx, field)
+			case "securityName":
+				return ec.fieldContext_CalendarEvent_securityName(ctx, field)
+			case "isin":
+				return ec.fieldContext_CalendarEvent_isin(ctx, field)
+			case "logoUrl":
+				return ec.fieldContext_CalendarEvent_logoUrl(ctx, field)
+			case "date":
+				return ec.fieldContext_CalendarEvent_date(ctx, field)
+			case "type":
+				return ec.fieldContext_CalendarEvent_type(ctx, field)
+			case "amount":
+				return ec.fieldContext_CalendarEvent_amount(ctx, field)
+			case "currencyCode":
+				return ec.fieldContext_CalendarEvent_currencyCode(ctx, field)
+			case "ratio":
+				return ec.fieldContext_CalendarEvent_ratio(ctx, field)
+			case "shares":
+				return ec.fieldContext_CalendarEvent_shares(ctx, field)
+			case "expectedIncome":
+				return ec.fieldContext_CalendarEvent_expectedIncome(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CalendarEvent", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deletePortfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Portfolio_id(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Portfolio_id(ctx, field)
+func (ec *executionContext) _EventCalendar_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.EventCalendar) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventCalendar_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3046,7 +3210,7 @@ func (ec *executionContext) _Portfolio_id(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3063,9 +3227,9 @@ func (ec *executionContext) _Portfolio_id(ctx context.Context, field graphql.Col
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Portfolio_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EventCalendar_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Portfolio",
+		Object:     "EventCalendar",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3076,8 +3240,8 @@ func (ec *executionContext) fieldContext_Portfolio_id(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Portfolio_name(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Portfolio_name(ctx, field)
+func (ec *executionContext) _Exchangerate_baseCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Exchangerate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3090,7 +3254,7 @@ func (ec *executionContext) _Portfolio_name(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.BaseCurrencyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3107,9 +3271,9 @@ func (ec *executionContext) _Portfolio_name(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Portfolio_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Exchangerate_baseCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Portfolio",
+		Object:     "Exchangerate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3120,8 +3284,8 @@ func (ec *executionContext) fieldContext_Portfolio_name(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Portfolio_note(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Portfolio_note(ctx, field)
+func (ec *executionContext) _Exchangerate_quoteCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Exchangerate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3134,7 +3298,7 @@ func (ec *executionContext) _Portfolio_note(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Note, nil
+		return obj.QuoteCurrencyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3151,9 +3315,9 @@ func (ec *executionContext) _Portfolio_note(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Portfolio_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Exchangerate_quoteCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Portfolio",
+		Object:     "Exchangerate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3164,8 +3328,8 @@ func (ec *executionContext) fieldContext_Portfolio_note(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Portfolio_baseCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
+func (ec *executionContext) _Exchangerate_prices(ctx context.Context, field graphql.CollectedField, obj *model.Exchangerate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Exchangerate_prices(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3178,7 +3342,7 @@ func (ec *executionContext) _Portfolio_baseCurrencyCode(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BaseCurrencyCode, nil
+		return ec.resolvers.Exchangerate().Prices(rctx, obj, fc.Args["from"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3190,70 +3354,43 @@ func (ec *executionContext) _Portfolio_baseCurrencyCode(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ExchangeratePrice)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNExchangeratePrice2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangeratePriceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Portfolio_baseCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Exchangerate_prices(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Portfolio",
+		Object:     "Exchangerate",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_ExchangeratePrice_date(ctx, field)
+			case "value":
+				return ec.fieldContext_ExchangeratePrice_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExchangeratePrice", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Portfolio_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Portfolio_createdAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Exchangerate_prices_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Portfolio_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Portfolio",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
+		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Portfolio_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Portfolio_updatedAt(ctx, field)
+func (ec *executionContext) _ExchangeratePrice_date(ctx context.Context, field graphql.CollectedField, obj *model.ExchangeratePrice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExchangeratePrice_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3266,7 +3403,7 @@ func (ec *executionContext) _Portfolio_updatedAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3278,26 +3415,26 @@ func (ec *executionContext) _Portfolio_updatedAt(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Portfolio_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExchangeratePrice_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Portfolio",
+		Object:     "ExchangeratePrice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_uuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_uuid(ctx, field)
+func (ec *executionContext) _ExchangeratePrice_value(ctx context.Context, field graphql.CollectedField, obj *model.ExchangeratePrice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExchangeratePrice_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3310,7 +3447,7 @@ func (ec *executionContext) _PortfolioAccount_uuid(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UUID, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3322,26 +3459,26 @@ func (ec *executionContext) _PortfolioAccount_uuid(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uuid.UUID)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExchangeratePrice_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "ExchangeratePrice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_type(ctx, field)
+func (ec *executionContext) _Mutation_register(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_register(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3354,7 +3491,7 @@ func (ec *executionContext) _PortfolioAccount_type(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Mutation().Register(rctx, fc.Args["username"].(string), fc.Args["password"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3366,26 +3503,49 @@ func (ec *executionContext) _PortfolioAccount_type(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PortfolioAccountType)
+	res := resTmp.(*model.Session)
 	fc.Result = res
-	return ec.marshalNPortfolioAccountType2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioAccountType(ctx, field.Selections, res)
+	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_register(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PortfolioAccountType does not have child fields")
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_Session_token(ctx, field)
+			case "note":
+				return ec.fieldContext_Session_note(ctx, field)
+			case "user":
+				return ec.fieldContext_Session_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActivityAt":
+				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_register_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_name(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_name(ctx, field)
+func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_login(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3398,7 +3558,7 @@ func (ec *executionContext) _PortfolioAccount_name(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().Login(rctx, fc.Args["username"].(string), fc.Args["password"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3410,26 +3570,49 @@ func (ec *executionContext) _PortfolioAccount_name(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Session)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_Session_token(ctx, field)
+			case "note":
+				return ec.fieldContext_Session_note(ctx, field)
+			case "user":
+				return ec.fieldContext_Session_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActivityAt":
+				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_currencyCode(ctx, field)
+func (ec *executionContext) _Mutation_createSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createSession(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3442,35 +3625,61 @@ func (ec *executionContext) _PortfolioAccount_currencyCode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CurrencyCode, nil
+		return ec.resolvers.Mutation().CreateSession(rctx, fc.Args["note"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.Session)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_Session_token(ctx, field)
+			case "note":
+				return ec.fieldContext_Session_note(ctx, field)
+			case "user":
+				return ec.fieldContext_Session_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActivityAt":
+				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_referenceAccountUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_referenceAccountUuid(ctx, field)
+func (ec *executionContext) _Mutation_deleteSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteSession(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3483,35 +3692,61 @@ func (ec *executionContext) _PortfolioAccount_referenceAccountUuid(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ReferenceAccountUUID, nil
+		return ec.resolvers.Mutation().DeleteSession(rctx, fc.Args["token"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uuid.UUID)
+	res := resTmp.(*model.Session)
 	fc.Result = res
-	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNSession2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSession(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_referenceAccountUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_Session_token(ctx, field)
+			case "note":
+				return ec.fieldContext_Session_note(ctx, field)
+			case "user":
+				return ec.fieldContext_Session_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActivityAt":
+				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_active(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_active(ctx, field)
+func (ec *executionContext) _Mutation_createPortfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createPortfolio(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3524,7 +3759,7 @@ func (ec *executionContext) _PortfolioAccount_active(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Active, nil
+		return ec.resolvers.Mutation().CreatePortfolio(rctx, fc.Args["portfolio"].(model.PortfolioInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3536,26 +3771,51 @@ func (ec *executionContext) _PortfolioAccount_active(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Portfolio)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createPortfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Portfolio_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Portfolio_name(ctx, field)
+			case "note":
+				return ec.fieldContext_Portfolio_note(ctx, field)
+			case "baseCurrencyCode":
+				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Portfolio_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createPortfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_note(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_note(ctx, field)
+func (ec *executionContext) _Mutation_updatePortfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updatePortfolio(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3568,7 +3828,7 @@ func (ec *executionContext) _PortfolioAccount_note(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Note, nil
+		return ec.resolvers.Mutation().UpdatePortfolio(rctx, fc.Args["id"].(int), fc.Args["portfolio"].(model.PortfolioInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3580,26 +3840,51 @@ func (ec *executionContext) _PortfolioAccount_note(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Portfolio)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updatePortfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Portfolio_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Portfolio_name(ctx, field)
+			case "note":
+				return ec.fieldContext_Portfolio_note(ctx, field)
+			case "baseCurrencyCode":
+				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Portfolio_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePortfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_updatedAt(ctx, field)
+func (ec *executionContext) _Mutation_deletePortfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deletePortfolio(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3612,7 +3897,7 @@ func (ec *executionContext) _PortfolioAccount_updatedAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return ec.resolvers.Mutation().DeletePortfolio(rctx, fc.Args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3624,26 +3909,51 @@ func (ec *executionContext) _PortfolioAccount_updatedAt(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.Portfolio)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deletePortfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Portfolio_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Portfolio_name(ctx, field)
+			case "note":
+				return ec.fieldContext_Portfolio_note(ctx, field)
+			case "baseCurrencyCode":
+				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Portfolio_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deletePortfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_balance(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_balance(ctx, field)
+func (ec *executionContext) _Portfolio_id(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Portfolio_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3656,7 +3966,7 @@ func (ec *executionContext) _PortfolioAccount_balance(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Balance, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3668,26 +3978,26 @@ func (ec *executionContext) _PortfolioAccount_balance(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_balance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Portfolio_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Portfolio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioAccount_value(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioAccount_value(ctx, field)
+func (ec *executionContext) _Portfolio_name(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Portfolio_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3700,7 +4010,7 @@ func (ec *executionContext) _PortfolioAccount_value(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.PortfolioAccount().Value(rctx, obj, fc.Args["currencyCode"].(*string))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3717,32 +4027,21 @@ func (ec *executionContext) _PortfolioAccount_value(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioAccount_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Portfolio_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioAccount",
+		Object:     "Portfolio",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_PortfolioAccount_value_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_portfolioId(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_portfolioId(ctx, field)
+func (ec *executionContext) _Portfolio_note(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Portfolio_note(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3755,7 +4054,7 @@ func (ec *executionContext) _PortfolioSecurity_portfolioId(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PortfolioID, nil
+		return obj.Note, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3767,26 +4066,26 @@ func (ec *executionContext) _PortfolioSecurity_portfolioId(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_portfolioId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Portfolio_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "Portfolio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_uuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_uuid(ctx, field)
+func (ec *executionContext) _Portfolio_baseCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3799,7 +4098,7 @@ func (ec *executionContext) _PortfolioSecurity_uuid(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UUID, nil
+		return obj.BaseCurrencyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3811,26 +4110,26 @@ func (ec *executionContext) _PortfolioSecurity_uuid(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uuid.UUID)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Portfolio_baseCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "Portfolio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_name(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_name(ctx, field)
+func (ec *executionContext) _Portfolio_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Portfolio_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3843,7 +4142,7 @@ func (ec *executionContext) _PortfolioSecurity_name(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3855,26 +4154,26 @@ func (ec *executionContext) _PortfolioSecurity_name(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Portfolio_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "Portfolio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_currencyCode(ctx, field)
+func (ec *executionContext) _Portfolio_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Portfolio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Portfolio_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3887,7 +4186,7 @@ func (ec *executionContext) _PortfolioSecurity_currencyCode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CurrencyCode, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3899,26 +4198,26 @@ func (ec *executionContext) _PortfolioSecurity_currencyCode(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Portfolio_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "Portfolio",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_isin(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_isin(ctx, field)
+func (ec *executionContext) _PortfolioAccount_uuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_uuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3931,7 +4230,7 @@ func (ec *executionContext) _PortfolioSecurity_isin(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Isin, nil
+		return obj.UUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3943,26 +4242,26 @@ func (ec *executionContext) _PortfolioSecurity_isin(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uuid.UUID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_isin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_wkn(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_wkn(ctx, field)
+func (ec *executionContext) _PortfolioAccount_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3975,7 +4274,7 @@ func (ec *executionContext) _PortfolioSecurity_wkn(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Wkn, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3987,26 +4286,26 @@ func (ec *executionContext) _PortfolioSecurity_wkn(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.PortfolioAccountType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPortfolioAccountType2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioAccountType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_wkn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type PortfolioAccountType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_symbol(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_symbol(ctx, field)
+func (ec *executionContext) _PortfolioAccount_name(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4019,7 +4318,7 @@ func (ec *executionContext) _PortfolioSecurity_symbol(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Symbol, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4036,9 +4335,9 @@ func (ec *executionContext) _PortfolioSecurity_symbol(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_symbol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4049,8 +4348,8 @@ func (ec *executionContext) fieldContext_PortfolioSecurity_symbol(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_active(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_active(ctx, field)
+func (ec *executionContext) _PortfolioAccount_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_currencyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4063,38 +4362,35 @@ func (ec *executionContext) _PortfolioSecurity_active(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Active, nil
+		return obj.CurrencyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_note(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_note(ctx, field)
+func (ec *executionContext) _PortfolioAccount_referenceAccountUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_referenceAccountUuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4107,38 +4403,35 @@ func (ec *executionContext) _PortfolioSecurity_note(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Note, nil
+		return obj.ReferenceAccountUUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*uuid.UUID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_referenceAccountUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_securityUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_securityUuid(ctx, field)
+func (ec *executionContext) _PortfolioAccount_active(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_active(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4151,35 +4444,38 @@ func (ec *executionContext) _PortfolioSecurity_securityUuid(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SecurityUUID, nil
+		return obj.Active, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uuid.UUID)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_securityUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_updatedAt(ctx, field)
+func (ec *executionContext) _PortfolioAccount_note(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_note(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4192,7 +4488,7 @@ func (ec *executionContext) _PortfolioSecurity_updatedAt(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Note, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4204,26 +4500,26 @@ func (ec *executionContext) _PortfolioSecurity_updatedAt(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_calendar(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_calendar(ctx, field)
+func (ec *executionContext) _PortfolioAccount_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4236,35 +4532,38 @@ func (ec *executionContext) _PortfolioSecurity_calendar(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Calendar, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_calendar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_feed(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_feed(ctx, field)
+func (ec *executionContext) _PortfolioAccount_balance(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_balance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4277,23 +4576,26 @@ func (ec *executionContext) _PortfolioSecurity_feed(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Feed, nil
+		return obj.Balance, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_feed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_balance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4304,8 +4606,8 @@ func (ec *executionContext) fieldContext_PortfolioSecurity_feed(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_feedUrl(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_feedUrl(ctx, field)
+func (ec *executionContext) _PortfolioAccount_value(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioAccount_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4318,35 +4620,49 @@ func (ec *executionContext) _PortfolioSecurity_feedUrl(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeedURL, nil
+		return ec.resolvers.PortfolioAccount().Value(rctx, obj, fc.Args["currencyCode"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_feedUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioAccount_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurity",
+		Object:     "PortfolioAccount",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_PortfolioAccount_value_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_latestFeed(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_latestFeed(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_portfolioId(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_portfolioId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4359,35 +4675,38 @@ func (ec *executionContext) _PortfolioSecurity_latestFeed(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LatestFeed, nil
+		return obj.PortfolioID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_latestFeed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_portfolioId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_latestFeedUrl(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_latestFeedUrl(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_uuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_uuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4400,35 +4719,38 @@ func (ec *executionContext) _PortfolioSecurity_latestFeedUrl(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LatestFeedURL, nil
+		return obj.UUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(uuid.UUID)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_latestFeedUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_events(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_events(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_name(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4441,7 +4763,7 @@ func (ec *executionContext) _PortfolioSecurity_events(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Events, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4453,34 +4775,26 @@ func (ec *executionContext) _PortfolioSecurity_events(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PortfolioSecurityEvent)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPortfolioSecurityEvent2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurityEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "date":
-				return ec.fieldContext_PortfolioSecurityEvent_date(ctx, field)
-			case "type":
-				return ec.fieldContext_PortfolioSecurityEvent_type(ctx, field)
-			case "details":
-				return ec.fieldContext_PortfolioSecurityEvent_details(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurityEvent", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_properties(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_properties(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_currencyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4493,7 +4807,7 @@ func (ec *executionContext) _PortfolioSecurity_properties(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Properties, nil
+		return obj.CurrencyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4505,34 +4819,26 @@ func (ec *executionContext) _PortfolioSecurity_properties(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PortfolioSecurityProperty)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPortfolioSecurityProperty2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurityPropertyᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_properties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_PortfolioSecurityProperty_name(ctx, field)
-			case "type":
-				return ec.fieldContext_PortfolioSecurityProperty_type(ctx, field)
-			case "value":
-				return ec.fieldContext_PortfolioSecurityProperty_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurityProperty", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_shares(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_shares(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_isin(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_isin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4545,7 +4851,7 @@ func (ec *executionContext) _PortfolioSecurity_shares(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.PortfolioSecurity().Shares(rctx, obj)
+		return obj.Isin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4557,26 +4863,26 @@ func (ec *executionContext) _PortfolioSecurity_shares(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*decimal.Decimal)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_shares(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_isin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PortfolioSecurity",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurity_quote(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurity_quote(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_wkn(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_wkn(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4589,7 +4895,7 @@ func (ec *executionContext) _PortfolioSecurity_quote(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Quote, nil
+		return obj.Wkn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4606,7 +4912,7 @@ func (ec *executionContext) _PortfolioSecurity_quote(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurity_quote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_wkn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PortfolioSecurity",
 		Field:      field,
@@ -4616,22 +4922,11 @@ func (ec *executionContext) fieldContext_PortfolioSecurity_quote(ctx context.Con
 			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_PortfolioSecurity_quote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurityEvent_date(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurityEvent_date(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_symbol(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_symbol(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4644,7 +4939,7 @@ func (ec *executionContext) _PortfolioSecurityEvent_date(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.Symbol, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4656,26 +4951,26 @@ func (ec *executionContext) _PortfolioSecurityEvent_date(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Date)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDate2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐDate(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurityEvent_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_symbol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurityEvent",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurityEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurityEvent_type(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_active(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_active(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4688,7 +4983,7 @@ func (ec *executionContext) _PortfolioSecurityEvent_type(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Active, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4700,26 +4995,26 @@ func (ec *executionContext) _PortfolioSecurityEvent_type(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurityEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurityEvent",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurityEvent_details(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurityEvent_details(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_note(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_note(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4732,7 +5027,7 @@ func (ec *executionContext) _PortfolioSecurityEvent_details(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Details, nil
+		return obj.Note, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4749,9 +5044,9 @@ func (ec *executionContext) _PortfolioSecurityEvent_details(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurityEvent_details(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurityEvent",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4762,8 +5057,8 @@ func (ec *executionContext) fieldContext_PortfolioSecurityEvent_details(ctx cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurityProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityProperty) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurityProperty_name(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_securityUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_securityUuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4776,38 +5071,35 @@ func (ec *executionContext) _PortfolioSecurityProperty_name(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.SecurityUUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*uuid.UUID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurityProperty_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_securityUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurityProperty",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurityProperty_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityProperty) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurityProperty_type(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4820,7 +5112,7 @@ func (ec *executionContext) _PortfolioSecurityProperty_type(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4832,26 +5124,26 @@ func (ec *executionContext) _PortfolioSecurityProperty_type(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurityProperty_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurityProperty",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioSecurityProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityProperty) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioSecurityProperty_value(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_calendar(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_calendar(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4864,26 +5156,23 @@ func (ec *executionContext) _PortfolioSecurityProperty_value(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Calendar, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioSecurityProperty_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_calendar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioSecurityProperty",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4894,8 +5183,8 @@ func (ec *executionContext) fieldContext_PortfolioSecurityProperty_value(ctx con
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_uuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_uuid(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_feed(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_feed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4908,38 +5197,35 @@ func (ec *executionContext) _PortfolioTransaction_uuid(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UUID, nil
+		return obj.Feed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uuid.UUID)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_feed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_accountUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_accountUuid(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_feedUrl(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_feedUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4952,38 +5238,35 @@ func (ec *executionContext) _PortfolioTransaction_accountUuid(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AccountUUID, nil
+		return obj.FeedURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uuid.UUID)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_accountUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_feedUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_type(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_latestFeed(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_latestFeed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4996,38 +5279,35 @@ func (ec *executionContext) _PortfolioTransaction_type(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.LatestFeed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PortfolioTransactionType)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPortfolioTransactionType2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioTransactionType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_latestFeed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PortfolioTransactionType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_datetime(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_datetime(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_latestFeedUrl(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_latestFeedUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5040,38 +5320,35 @@ func (ec *executionContext) _PortfolioTransaction_datetime(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Datetime, nil
+		return obj.LatestFeedURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_datetime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_latestFeedUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_partnerTransactionUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_partnerTransactionUuid(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_events(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_events(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5084,35 +5361,46 @@ func (ec *executionContext) _PortfolioTransaction_partnerTransactionUuid(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PartnerTransactionUUID, nil
+		return obj.Events, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uuid.UUID)
+	res := resTmp.([]*model.PortfolioSecurityEvent)
 	fc.Result = res
-	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNPortfolioSecurityEvent2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurityEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_partnerTransactionUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_PortfolioSecurityEvent_date(ctx, field)
+			case "type":
+				return ec.fieldContext_PortfolioSecurityEvent_type(ctx, field)
+			case "details":
+				return ec.fieldContext_PortfolioSecurityEvent_details(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurityEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_shares(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_shares(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_properties(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5125,35 +5413,46 @@ func (ec *executionContext) _PortfolioTransaction_shares(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Shares, nil
+		return obj.Properties, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*decimal.Decimal)
+	res := resTmp.([]*model.PortfolioSecurityProperty)
 	fc.Result = res
-	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+	return ec.marshalNPortfolioSecurityProperty2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurityPropertyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_shares(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_properties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_PortfolioSecurityProperty_name(ctx, field)
+			case "type":
+				return ec.fieldContext_PortfolioSecurityProperty_type(ctx, field)
+			case "value":
+				return ec.fieldContext_PortfolioSecurityProperty_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurityProperty", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_portfolioSecurityUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_portfolioSecurityUuid(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_shares(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_shares(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5166,35 +5465,38 @@ func (ec *executionContext) _PortfolioTransaction_portfolioSecurityUuid(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PortfolioSecurityUUID, nil
+		return ec.resolvers.PortfolioSecurity().Shares(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uuid.UUID)
+	res := resTmp.(*decimal.Decimal)
 	fc.Result = res
-	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNDecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_portfolioSecurityUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_shares(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_note(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_note(ctx, field)
+func (ec *executionContext) _PortfolioSecurity_quote(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurity_quote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5207,7 +5509,7 @@ func (ec *executionContext) _PortfolioTransaction_note(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Note, nil
+		return obj.Quote, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5224,9 +5526,9 @@ func (ec *executionContext) _PortfolioTransaction_note(ctx context.Context, fiel
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurity_quote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5234,11 +5536,22 @@ func (ec *executionContext) fieldContext_PortfolioTransaction_note(ctx context.C
 			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_PortfolioSecurity_quote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_updatedAt(ctx, field)
+func (ec *executionContext) _PortfolioSecurityEvent_date(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurityEvent_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5251,7 +5564,7 @@ func (ec *executionContext) _PortfolioTransaction_updatedAt(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5263,26 +5576,26 @@ func (ec *executionContext) _PortfolioTransaction_updatedAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(model.Date)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNDate2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurityEvent_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurityEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransaction_units(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransaction_units(ctx, field)
+func (ec *executionContext) _PortfolioSecurityEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurityEvent_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5295,7 +5608,7 @@ func (ec *executionContext) _PortfolioTransaction_units(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Units, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5307,40 +5620,26 @@ func (ec *executionContext) _PortfolioTransaction_units(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PortfolioTransactionUnit)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPortfolioTransactionUnit2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioTransactionUnitᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransaction_units(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurityEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransaction",
+		Object:     "PortfolioSecurityEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "type":
-				return ec.fieldContext_PortfolioTransactionUnit_type(ctx, field)
-			case "amount":
-				return ec.fieldContext_PortfolioTransactionUnit_amount(ctx, field)
-			case "currencyCode":
-				return ec.fieldContext_PortfolioTransactionUnit_currencyCode(ctx, field)
-			case "originalAmount":
-				return ec.fieldContext_PortfolioTransactionUnit_originalAmount(ctx, field)
-			case "originalCurrencyCode":
-				return ec.fieldContext_PortfolioTransactionUnit_originalCurrencyCode(ctx, field)
-			case "exchangeRate":
-				return ec.fieldContext_PortfolioTransactionUnit_exchangeRate(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PortfolioTransactionUnit", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransactionUnit_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransactionUnit_type(ctx, field)
+func (ec *executionContext) _PortfolioSecurityEvent_details(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurityEvent_details(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5353,7 +5652,7 @@ func (ec *executionContext) _PortfolioTransactionUnit_type(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Details, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5365,26 +5664,26 @@ func (ec *executionContext) _PortfolioTransactionUnit_type(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PortfolioTransactionUnitType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPortfolioTransactionUnitType2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioTransactionUnitType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransactionUnit_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurityEvent_details(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransactionUnit",
+		Object:     "PortfolioSecurityEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PortfolioTransactionUnitType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransactionUnit_amount(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransactionUnit_amount(ctx, field)
+func (ec *executionContext) _PortfolioSecurityProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityProperty) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurityProperty_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5397,7 +5696,7 @@ func (ec *executionContext) _PortfolioTransactionUnit_amount(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5409,26 +5708,26 @@ func (ec *executionContext) _PortfolioTransactionUnit_amount(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(decimal.Decimal)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDecimal2githubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransactionUnit_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurityProperty_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransactionUnit",
+		Object:     "PortfolioSecurityProperty",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransactionUnit_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransactionUnit_currencyCode(ctx, field)
+func (ec *executionContext) _PortfolioSecurityProperty_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityProperty) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurityProperty_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5441,7 +5740,7 @@ func (ec *executionContext) _PortfolioTransactionUnit_currencyCode(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CurrencyCode, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5458,9 +5757,9 @@ func (ec *executionContext) _PortfolioTransactionUnit_currencyCode(ctx context.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransactionUnit_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurityProperty_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransactionUnit",
+		Object:     "PortfolioSecurityProperty",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5471,8 +5770,8 @@ func (ec *executionContext) fieldContext_PortfolioTransactionUnit_currencyCode(c
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransactionUnit_originalAmount(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransactionUnit_originalAmount(ctx, field)
+func (ec *executionContext) _PortfolioSecurityProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioSecurityProperty) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioSecurityProperty_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5485,35 +5784,38 @@ func (ec *executionContext) _PortfolioTransactionUnit_originalAmount(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginalAmount, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*decimal.Decimal)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransactionUnit_originalAmount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioSecurityProperty_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransactionUnit",
+		Object:     "PortfolioSecurityProperty",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransactionUnit_originalCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransactionUnit_originalCurrencyCode(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_uuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_uuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5526,35 +5828,38 @@ func (ec *executionContext) _PortfolioTransactionUnit_originalCurrencyCode(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginalCurrencyCode, nil
+		return obj.UUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(uuid.UUID)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransactionUnit_originalCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransactionUnit",
+		Object:     "PortfolioTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PortfolioTransactionUnit_exchangeRate(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PortfolioTransactionUnit_exchangeRate(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_accountUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_accountUuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5567,35 +5872,38 @@ func (ec *executionContext) _PortfolioTransactionUnit_exchangeRate(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExchangeRate, nil
+		return obj.AccountUUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*decimal.Decimal)
+	res := resTmp.(uuid.UUID)
 	fc.Result = res
-	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PortfolioTransactionUnit_exchangeRate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_accountUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PortfolioTransactionUnit",
+		Object:     "PortfolioTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_currencies(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_currencies(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5608,7 +5916,7 @@ func (ec *executionContext) _Query_currencies(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Currencies(rctx)
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5620,34 +5928,26 @@ func (ec *executionContext) _Query_currencies(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Currency)
+	res := resTmp.(model.PortfolioTransactionType)
 	fc.Result = res
-	return ec.marshalNCurrency2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐCurrencyᚄ(ctx, field.Selections, res)
+	return ec.marshalNPortfolioTransactionType2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioTransactionType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_currencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PortfolioTransaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_Currency_code(ctx, field)
-			case "exchangeratesBase":
-				return ec.fieldContext_Currency_exchangeratesBase(ctx, field)
-			case "exchangeratesQuote":
-				return ec.fieldContext_Currency_exchangeratesQuote(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Currency", field.Name)
+			return nil, errors.New("field of type PortfolioTransactionType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_exchangerate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_exchangerate(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_datetime(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_datetime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5660,7 +5960,7 @@ func (ec *executionContext) _Query_exchangerate(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Exchangerate(rctx, fc.Args["baseCurrencyCode"].(string), fc.Args["quoteCurrencyCode"].(string))
+		return obj.Datetime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5672,45 +5972,26 @@ func (ec *executionContext) _Query_exchangerate(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Exchangerate)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNExchangerate2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangerate(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_exchangerate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_datetime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PortfolioTransaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "baseCurrencyCode":
-				return ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
-			case "quoteCurrencyCode":
-				return ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
-			case "prices":
-				return ec.fieldContext_Exchangerate_prices(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Exchangerate", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_exchangerate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_portfolios(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_portfolios(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_partnerTransactionUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_partnerTransactionUuid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5723,52 +6004,35 @@ func (ec *executionContext) _Query_portfolios(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Portfolios(rctx)
+		return obj.PartnerTransactionUUID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Portfolio)
+	res := resTmp.(*uuid.UUID)
 	fc.Result = res
-	return ec.marshalNPortfolio2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioᚄ(ctx, field.Selections, res)
+	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_portfolios(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_partnerTransactionUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PortfolioTransaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Portfolio_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Portfolio_name(ctx, field)
-			case "note":
-				return ec.fieldContext_Portfolio_note(ctx, field)
-			case "baseCurrencyCode":
-				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Portfolio_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_portfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_portfolio(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_shares(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_shares(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5781,63 +6045,76 @@ func (ec *executionContext) _Query_portfolio(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Portfolio(rctx, fc.Args["id"].(int))
+		return obj.Shares, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Portfolio)
+	res := resTmp.(*decimal.Decimal)
 	fc.Result = res
-	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
+	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_portfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_shares(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PortfolioTransaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Portfolio_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Portfolio_name(ctx, field)
-			case "note":
-				return ec.fieldContext_Portfolio_note(ctx, field)
-			case "baseCurrencyCode":
-				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Portfolio_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PortfolioTransaction_portfolioSecurityUuid(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_portfolioSecurityUuid(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_portfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PortfolioSecurityUUID, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*uuid.UUID)
+	fc.Result = res
+	return ec.marshalOUUID2ᚖgithubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PortfolioTransaction_portfolioSecurityUuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PortfolioTransaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_portfolioAccounts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_portfolioAccounts(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_note(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_note(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5850,7 +6127,7 @@ func (ec *executionContext) _Query_portfolioAccounts(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().PortfolioAccounts(rctx, fc.Args["portfolioId"].(int))
+		return obj.Note, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5862,59 +6139,26 @@ func (ec *executionContext) _Query_portfolioAccounts(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PortfolioAccount)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPortfolioAccount2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioAccountᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_portfolioAccounts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PortfolioTransaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "uuid":
-				return ec.fieldContext_PortfolioAccount_uuid(ctx, field)
-			case "type":
-				return ec.fieldContext_PortfolioAccount_type(ctx, field)
-			case "name":
-				return ec.fieldContext_PortfolioAccount_name(ctx, field)
-			case "currencyCode":
-				return ec.fieldContext_PortfolioAccount_currencyCode(ctx, field)
-			case "referenceAccountUuid":
-				return ec.fieldContext_PortfolioAccount_referenceAccountUuid(ctx, field)
-			case "active":
-				return ec.fieldContext_PortfolioAccount_active(ctx, field)
-			case "note":
-				return ec.fieldContext_PortfolioAccount_note(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_PortfolioAccount_updatedAt(ctx, field)
-			case "balance":
-				return ec.fieldContext_PortfolioAccount_balance(ctx, field)
-			case "value":
-				return ec.fieldContext_PortfolioAccount_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PortfolioAccount", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_portfolioAccounts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_portfolioSecurities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_portfolioSecurities(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5927,7 +6171,7 @@ func (ec *executionContext) _Query_portfolioSecurities(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().PortfolioSecurities(rctx, fc.Args["portfolioId"].(int))
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5939,79 +6183,26 @@ func (ec *executionContext) _Query_portfolioSecurities(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PortfolioSecurity)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNPortfolioSecurity2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurityᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_portfolioSecurities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PortfolioTransaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "portfolioId":
-				return ec.fieldContext_PortfolioSecurity_portfolioId(ctx, field)
-			case "uuid":
-				return ec.fieldContext_PortfolioSecurity_uuid(ctx, field)
-			case "name":
-				return ec.fieldContext_PortfolioSecurity_name(ctx, field)
-			case "currencyCode":
-				return ec.fieldContext_PortfolioSecurity_currencyCode(ctx, field)
-			case "isin":
-				return ec.fieldContext_PortfolioSecurity_isin(ctx, field)
-			case "wkn":
-				return ec.fieldContext_PortfolioSecurity_wkn(ctx, field)
-			case "symbol":
-				return ec.fieldContext_PortfolioSecurity_symbol(ctx, field)
-			case "active":
-				return ec.fieldContext_PortfolioSecurity_active(ctx, field)
-			case "note":
-				return ec.fieldContext_PortfolioSecurity_note(ctx, field)
-			case "securityUuid":
-				return ec.fieldContext_PortfolioSecurity_securityUuid(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_PortfolioSecurity_updatedAt(ctx, field)
-			case "calendar":
-				return ec.fieldContext_PortfolioSecurity_calendar(ctx, field)
-			case "feed":
-				return ec.fieldContext_PortfolioSecurity_feed(ctx, field)
-			case "feedUrl":
-				return ec.fieldContext_PortfolioSecurity_feedUrl(ctx, field)
-			case "latestFeed":
-				return ec.fieldContext_PortfolioSecurity_latestFeed(ctx, field)
-			case "latestFeedUrl":
-				return ec.fieldContext_PortfolioSecurity_latestFeedUrl(ctx, field)
-			case "events":
-				return ec.fieldContext_PortfolioSecurity_events(ctx, field)
-			case "properties":
-				return ec.fieldContext_PortfolioSecurity_properties(ctx, field)
-			case "shares":
-				return ec.fieldContext_PortfolioSecurity_shares(ctx, field)
-			case "quote":
-				return ec.fieldContext_PortfolioSecurity_quote(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurity", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_portfolioSecurities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_portfolioSecurity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_portfolioSecurity(ctx, field)
+func (ec *executionContext) _PortfolioTransaction_units(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransaction_units(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6024,7 +6215,7 @@ func (ec *executionContext) _Query_portfolioSecurity(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().PortfolioSecurity(rctx, fc.Args["portfolioId"].(int), fc.Args["uuid"].(uuid.UUID))
+		return obj.Units, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6036,25 +6227,754 @@ func (ec *executionContext) _Query_portfolioSecurity(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PortfolioSecurity)
+	res := resTmp.([]*model.PortfolioTransactionUnit)
 	fc.Result = res
-	return ec.marshalNPortfolioSecurity2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurity(ctx, field.Selections, res)
+	return ec.marshalNPortfolioTransactionUnit2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioTransactionUnitᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_portfolioSecurity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PortfolioTransaction_units(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PortfolioTransaction",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "portfolioId":
-				return ec.fieldContext_PortfolioSecurity_portfolioId(ctx, field)
-			case "uuid":
-				return ec.fieldContext_PortfolioSecurity_uuid(ctx, field)
-			case "name":
-				return ec.fieldContext_PortfolioSecurity_name(ctx, field)
+			case "type":
+				return ec.fieldContext_PortfolioTransactionUnit_type(ctx, field)
+			case "amount":
+				return ec.fieldContext_PortfolioTransactionUnit_amount(ctx, field)
+			case "currencyCode":
+				return ec.fieldContext_PortfolioTransactionUnit_currencyCode(ctx, field)
+			case "originalAmount":
+				return ec.fieldContext_PortfolioTransactionUnit_originalAmount(ctx, field)
+			case "originalCurrencyCode":
+				return ec.fieldContext_PortfolioTransactionUnit_originalCurrencyCode(ctx, field)
+			case "exchangeRate":
+				return ec.fieldContext_PortfolioTransactionUnit_exchangeRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PortfolioTransactionUnit", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PortfolioTransactionUnit_type(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransactionUnit_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PortfolioTransactionUnitType)
+	fc.Result = res
+	return ec.marshalNPortfolioTransactionUnitType2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioTransactionUnitType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PortfolioTransactionUnit_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PortfolioTransactionUnit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PortfolioTransactionUnitType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PortfolioTransactionUnit_amount(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransactionUnit_amount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(decimal.Decimal)
+	fc.Result = res
+	return ec.marshalNDecimal2githubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PortfolioTransactionUnit_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PortfolioTransactionUnit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PortfolioTransactionUnit_currencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransactionUnit_currencyCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CurrencyCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PortfolioTransactionUnit_currencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PortfolioTransactionUnit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PortfolioTransactionUnit_originalAmount(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransactionUnit_originalAmount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OriginalAmount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*decimal.Decimal)
+	fc.Result = res
+	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PortfolioTransactionUnit_originalAmount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PortfolioTransactionUnit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PortfolioTransactionUnit_originalCurrencyCode(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransactionUnit_originalCurrencyCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OriginalCurrencyCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PortfolioTransactionUnit_originalCurrencyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PortfolioTransactionUnit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PortfolioTransactionUnit_exchangeRate(ctx context.Context, field graphql.CollectedField, obj *model.PortfolioTransactionUnit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PortfolioTransactionUnit_exchangeRate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExchangeRate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*decimal.Decimal)
+	fc.Result = res
+	return ec.marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecimalᚐDecimal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PortfolioTransactionUnit_exchangeRate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PortfolioTransactionUnit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_currencies(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_currencies(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Currencies(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Currency)
+	fc.Result = res
+	return ec.marshalNCurrency2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐCurrencyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_currencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Currency_code(ctx, field)
+			case "exchangeratesBase":
+				return ec.fieldContext_Currency_exchangeratesBase(ctx, field)
+			case "exchangeratesQuote":
+				return ec.fieldContext_Currency_exchangeratesQuote(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Currency", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_exchangerate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_exchangerate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Exchangerate(rctx, fc.Args["baseCurrencyCode"].(string), fc.Args["quoteCurrencyCode"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Exchangerate)
+	fc.Result = res
+	return ec.marshalNExchangerate2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangerate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_exchangerate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "baseCurrencyCode":
+				return ec.fieldContext_Exchangerate_baseCurrencyCode(ctx, field)
+			case "quoteCurrencyCode":
+				return ec.fieldContext_Exchangerate_quoteCurrencyCode(ctx, field)
+			case "prices":
+				return ec.fieldContext_Exchangerate_prices(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Exchangerate", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_exchangerate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_portfolios(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_portfolios(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Portfolios(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Portfolio)
+	fc.Result = res
+	return ec.marshalNPortfolio2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_portfolios(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Portfolio_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Portfolio_name(ctx, field)
+			case "note":
+				return ec.fieldContext_Portfolio_note(ctx, field)
+			case "baseCurrencyCode":
+				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Portfolio_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_portfolio(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_portfolio(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Portfolio(rctx, fc.Args["id"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Portfolio)
+	fc.Result = res
+	return ec.marshalNPortfolio2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolio(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_portfolio(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Portfolio_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Portfolio_name(ctx, field)
+			case "note":
+				return ec.fieldContext_Portfolio_note(ctx, field)
+			case "baseCurrencyCode":
+				return ec.fieldContext_Portfolio_baseCurrencyCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Portfolio_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Portfolio_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Portfolio", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_portfolio_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_portfolioAccounts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_portfolioAccounts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PortfolioAccounts(rctx, fc.Args["portfolioId"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PortfolioAccount)
+	fc.Result = res
+	return ec.marshalNPortfolioAccount2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioAccountᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_portfolioAccounts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "uuid":
+				return ec.fieldContext_PortfolioAccount_uuid(ctx, field)
+			case "type":
+				return ec.fieldContext_PortfolioAccount_type(ctx, field)
+			case "name":
+				return ec.fieldContext_PortfolioAccount_name(ctx, field)
+			case "currencyCode":
+				return ec.fieldContext_PortfolioAccount_currencyCode(ctx, field)
+			case "referenceAccountUuid":
+				return ec.fieldContext_PortfolioAccount_referenceAccountUuid(ctx, field)
+			case "active":
+				return ec.fieldContext_PortfolioAccount_active(ctx, field)
+			case "note":
+				return ec.fieldContext_PortfolioAccount_note(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PortfolioAccount_updatedAt(ctx, field)
+			case "balance":
+				return ec.fieldContext_PortfolioAccount_balance(ctx, field)
+			case "value":
+				return ec.fieldContext_PortfolioAccount_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PortfolioAccount", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_portfolioAccounts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_portfolioSecurities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_portfolioSecurities(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PortfolioSecurities(rctx, fc.Args["portfolioId"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PortfolioSecurity)
+	fc.Result = res
+	return ec.marshalNPortfolioSecurity2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurityᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_portfolioSecurities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "portfolioId":
+				return ec.fieldContext_PortfolioSecurity_portfolioId(ctx, field)
+			case "uuid":
+				return ec.fieldContext_PortfolioSecurity_uuid(ctx, field)
+			case "name":
+				return ec.fieldContext_PortfolioSecurity_name(ctx, field)
+			case "currencyCode":
+				return ec.fieldContext_PortfolioSecurity_currencyCode(ctx, field)
+			case "isin":
+				return ec.fieldContext_PortfolioSecurity_isin(ctx, field)
+			case "wkn":
+				return ec.fieldContext_PortfolioSecurity_wkn(ctx, field)
+			case "symbol":
+				return ec.fieldContext_PortfolioSecurity_symbol(ctx, field)
+			case "active":
+				return ec.fieldContext_PortfolioSecurity_active(ctx, field)
+			case "note":
+				return ec.fieldContext_PortfolioSecurity_note(ctx, field)
+			case "securityUuid":
+				return ec.fieldContext_PortfolioSecurity_securityUuid(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PortfolioSecurity_updatedAt(ctx, field)
+			case "calendar":
+				return ec.fieldContext_PortfolioSecurity_calendar(ctx, field)
+			case "feed":
+				return ec.fieldContext_PortfolioSecurity_feed(ctx, field)
+			case "feedUrl":
+				return ec.fieldContext_PortfolioSecurity_feedUrl(ctx, field)
+			case "latestFeed":
+				return ec.fieldContext_PortfolioSecurity_latestFeed(ctx, field)
+			case "latestFeedUrl":
+				return ec.fieldContext_PortfolioSecurity_latestFeedUrl(ctx, field)
+			case "events":
+				return ec.fieldContext_PortfolioSecurity_events(ctx, field)
+			case "properties":
+				return ec.fieldContext_PortfolioSecurity_properties(ctx, field)
+			case "shares":
+				return ec.fieldContext_PortfolioSecurity_shares(ctx, field)
+			case "quote":
+				return ec.fieldContext_PortfolioSecurity_quote(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurity", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_portfolioSecurities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_portfolioSecurity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_portfolioSecurity(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PortfolioSecurity(rctx, fc.Args["portfolioId"].(int), fc.Args["uuid"].(uuid.UUID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PortfolioSecurity)
+	fc.Result = res
+	return ec.marshalNPortfolioSecurity2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐPortfolioSecurity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_portfolioSecurity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "portfolioId":
+				return ec.fieldContext_PortfolioSecurity_portfolioId(ctx, field)
+			case "uuid":
+				return ec.fieldContext_PortfolioSecurity_uuid(ctx, field)
+			case "name":
+				return ec.fieldContext_PortfolioSecurity_name(ctx, field)
 			case "currencyCode":
 				return ec.fieldContext_PortfolioSecurity_currencyCode(ctx, field)
 			case "isin":
@@ -6090,7 +7010,285 @@ func (ec *executionContext) fieldContext_Query_portfolioSecurity(ctx context.Con
 			case "quote":
 				return ec.fieldContext_PortfolioSecurity_quote(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurity", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PortfolioSecurity", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_portfolioSecurity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_security(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_security(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Security(rctx, fc.Args["uuid"].(uuid.UUID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Security)
+	fc.Result = res
+	return ec.marshalNSecurity2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_security(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "uuid":
+				return ec.fieldContext_Security_uuid(ctx, field)
+			case "name":
+				return ec.fieldContext_Security_name(ctx, field)
+			case "isin":
+				return ec.fieldContext_Security_isin(ctx, field)
+			case "wkn":
+				return ec.fieldContext_Security_wkn(ctx, field)
+			case "securityType":
+				return ec.fieldContext_Security_securityType(ctx, field)
+			case "symbolXfra":
+				return ec.fieldContext_Security_symbolXfra(ctx, field)
+			case "symbolXnas":
+				return ec.fieldContext_Security_symbolXnas(ctx, field)
+			case "symbolXnys":
+				return ec.fieldContext_Security_symbolXnys(ctx, field)
+			case "logoUrl":
+				return ec.fieldContext_Security_logoUrl(ctx, field)
+			case "logoThumbUrl":
+				return ec.fieldContext_Security_logoThumbUrl(ctx, field)
+			case "identifiers":
+				return ec.fieldContext_Security_identifiers(ctx, field)
+			case "aliases":
+				return ec.fieldContext_Security_aliases(ctx, field)
+			case "securityMarkets":
+				return ec.fieldContext_Security_securityMarkets(ctx, field)
+			case "securityTaxonomies":
+				return ec.fieldContext_Security_securityTaxonomies(ctx, field)
+			case "events":
+				return ec.fieldContext_Security_events(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Security", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_security_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_eventCalendar(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_eventCalendar(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().EventCalendar(rctx, fc.Args["from"].(*model.Date), fc.Args["to"].(*model.Date), fc.Args["type"].(*string), fc.Args["tag"].(*string), fc.Args["securityType"].(*string), fc.Args["portfolioId"].(*int), fc.Args["limit"].(*int), fc.Args["skip"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.EventCalendar)
+	fc.Result = res
+	return ec.marshalNEventCalendar2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐEventCalendar(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_eventCalendar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entries":
+				return ec.fieldContext_EventCalendar_entries(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_EventCalendar_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EventCalendar", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_eventCalendar_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sessions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sessions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Sessions(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Session)
+	fc.Result = res
+	return ec.marshalNSession2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSessionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sessions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_Session_token(ctx, field)
+			case "note":
+				return ec.fieldContext_Session_note(ctx, field)
+			case "user":
+				return ec.fieldContext_Session_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActivityAt":
+				return ec.fieldContext_Session_lastActivityAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
 	defer func() {
@@ -6100,15 +7298,237 @@ func (ec *executionContext) fieldContext_Query_portfolioSecurity(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_portfolioSecurity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_security(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_security(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Security_uuid(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_uuid(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UUID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uuid.UUID)
+	fc.Result = res
+	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Security_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Security",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Security_name(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Security_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Security",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Security_isin(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_isin(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Isin, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Security_isin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Security",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Security_wkn(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_wkn(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Wkn, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Security_wkn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Security",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Security_securityType(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_securityType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6121,75 +7541,35 @@ func (ec *executionContext) _Query_security(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Security(rctx, fc.Args["uuid"].(uuid.UUID))
+		return obj.SecurityType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Security)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSecurity2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurity(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_security(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_securityType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Security",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "uuid":
-				return ec.fieldContext_Security_uuid(ctx, field)
-			case "name":
-				return ec.fieldContext_Security_name(ctx, field)
-			case "isin":
-				return ec.fieldContext_Security_isin(ctx, field)
-			case "wkn":
-				return ec.fieldContext_Security_wkn(ctx, field)
-			case "securityType":
-				return ec.fieldContext_Security_securityType(ctx, field)
-			case "symbolXfra":
-				return ec.fieldContext_Security_symbolXfra(ctx, field)
-			case "symbolXnas":
-				return ec.fieldContext_Security_symbolXnas(ctx, field)
-			case "symbolXnys":
-				return ec.fieldContext_Security_symbolXnys(ctx, field)
-			case "logoUrl":
-				return ec.fieldContext_Security_logoUrl(ctx, field)
-			case "securityMarkets":
-				return ec.fieldContext_Security_securityMarkets(ctx, field)
-			case "securityTaxonomies":
-				return ec.fieldContext_Security_securityTaxonomies(ctx, field)
-			case "events":
-				return ec.fieldContext_Security_events(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Security", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_security_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sessions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_sessions(ctx, field)
+func (ec *executionContext) _Security_symbolXfra(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_symbolXfra(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6202,50 +7582,35 @@ func (ec *executionContext) _Query_sessions(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Sessions(rctx)
+		return obj.SymbolXfra, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Session)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSession2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSessionᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_sessions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_symbolXfra(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Security",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_Session_token(ctx, field)
-			case "note":
-				return ec.fieldContext_Session_note(ctx, field)
-			case "user":
-				return ec.fieldContext_Session_user(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "lastActivityAt":
-				return ec.fieldContext_Session_lastActivityAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _Security_symbolXnas(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_symbolXnas(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6258,7 +7623,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.SymbolXnas, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6267,59 +7632,26 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_symbolXnas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Security",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _Security_symbolXnys(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_symbolXnys(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6332,7 +7664,7 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.SymbolXnys, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6341,40 +7673,26 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_symbolXnys(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Security",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_uuid(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_uuid(ctx, field)
+func (ec *executionContext) _Security_logoUrl(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_logoUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6387,38 +7705,35 @@ func (ec *executionContext) _Security_uuid(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UUID, nil
+		return obj.LogoURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uuid.UUID)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNUUID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_uuid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_logoUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Security",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_name(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_name(ctx, field)
+func (ec *executionContext) _Security_logoThumbUrl(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_logoThumbUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6431,7 +7746,7 @@ func (ec *executionContext) _Security_name(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.LogoThumbURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6445,7 +7760,7 @@ func (ec *executionContext) _Security_name(ctx context.Context, field graphql.Co
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_logoThumbUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Security",
 		Field:      field,
@@ -6458,8 +7773,8 @@ func (ec *executionContext) fieldContext_Security_name(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_isin(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_isin(ctx, field)
+func (ec *executionContext) _Security_identifiers(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_identifiers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6472,35 +7787,46 @@ func (ec *executionContext) _Security_isin(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Isin, nil
+		return obj.Identifiers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.SecurityIdentifier)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNSecurityIdentifier2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifierᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_isin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_identifiers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Security",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "scheme":
+				return ec.fieldContext_SecurityIdentifier_scheme(ctx, field)
+			case "mic":
+				return ec.fieldContext_SecurityIdentifier_mic(ctx, field)
+			case "value":
+				return ec.fieldContext_SecurityIdentifier_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SecurityIdentifier", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_wkn(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_wkn(ctx, field)
+func (ec *executionContext) _Security_aliases(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_aliases(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6513,35 +7839,44 @@ func (ec *executionContext) _Security_wkn(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Wkn, nil
+		return obj.Aliases, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.SecurityAlias)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNSecurityAlias2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAliasᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_wkn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_aliases(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Security",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_SecurityAlias_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_SecurityAlias_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SecurityAlias", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_securityType(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_securityType(ctx, field)
+func (ec *executionContext) _Security_securityMarkets(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_securityMarkets(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6554,35 +7889,54 @@ func (ec *executionContext) _Security_securityType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SecurityType, nil
+		return obj.SecurityMarkets, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.SecurityMarket)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNSecurityMarket2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityMarketᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_securityType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_securityMarkets(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Security",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "securityUuid":
+				return ec.fieldContext_SecurityMarket_securityUuid(ctx, field)
+			case "marketCode":
+				return ec.fieldContext_SecurityMarket_marketCode(ctx, field)
+			case "currencyCode":
+				return ec.fieldContext_SecurityMarket_currencyCode(ctx, field)
+			case "firstPriceDate":
+				return ec.fieldContext_SecurityMarket_firstPriceDate(ctx, field)
+			case "lastPriceDate":
+				return ec.fieldContext_SecurityMarket_lastPriceDate(ctx, field)
+			case "symbol":
+				return ec.fieldContext_SecurityMarket_symbol(ctx, field)
+			case "updatePrices":
+				return ec.fieldContext_SecurityMarket_updatePrices(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SecurityMarket", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_symbolXfra(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_symbolXfra(ctx, field)
+func (ec *executionContext) _Security_securityTaxonomies(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_securityTaxonomies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6595,35 +7949,48 @@ func (ec *executionContext) _Security_symbolXfra(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SymbolXfra, nil
+		return ec.resolvers.Security().SecurityTaxonomies(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.SecurityTaxonomy)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNSecurityTaxonomy2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityTaxonomyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_symbolXfra(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_securityTaxonomies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Security",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "securityUuid":
+				return ec.fieldContext_SecurityTaxonomy_securityUuid(ctx, field)
+			case "taxonomyUuid":
+				return ec.fieldContext_SecurityTaxonomy_taxonomyUuid(ctx, field)
+			case "weight":
+				return ec.fieldContext_SecurityTaxonomy_weight(ctx, field)
+			case "taxonomy":
+				return ec.fieldContext_SecurityTaxonomy_taxonomy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SecurityTaxonomy", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_symbolXnas(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_symbolXnas(ctx, field)
+func (ec *executionContext) _Security_events(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Security_events(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6636,35 +8003,50 @@ func (ec *executionContext) _Security_symbolXnas(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SymbolXnas, nil
+		return ec.resolvers.Security().Events(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_symbolXnas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Security_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Security",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_Event_date(ctx, field)
+			case "type":
+				return ec.fieldContext_Event_type(ctx, field)
+			case "amount":
+				return ec.fieldContext_Event_amount(ctx, field)
+			case "currencyCode":
+				return ec.fieldContext_Event_currencyCode(ctx, field)
+			case "ratio":
+				return ec.fieldContext_Event_ratio(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Event", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_symbolXnys(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_symbolXnys(ctx, field)
+func (ec *executionContext) _SecurityAlias_kind(ctx context.Context, field graphql.CollectedField, obj *model.SecurityAlias) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SecurityAlias_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6677,23 +8059,26 @@ func (ec *executionContext) _Security_symbolXnys(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SymbolXnys, nil
+		return obj.Kind, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_symbolXnys(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SecurityAlias_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Security",
+		Object:     "SecurityAlias",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6704,8 +8089,8 @@ func (ec *executionContext) fieldContext_Security_symbolXnys(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_logoUrl(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_logoUrl(ctx, field)
+func (ec *executionContext) _SecurityAlias_name(ctx context.Context, field graphql.CollectedField, obj *model.SecurityAlias) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SecurityAlias_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6718,23 +8103,26 @@ func (ec *executionContext) _Security_logoUrl(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LogoURL, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_logoUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SecurityAlias_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Security",
+		Object:     "SecurityAlias",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6745,8 +8133,8 @@ func (ec *executionContext) fieldContext_Security_logoUrl(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_securityMarkets(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_securityMarkets(ctx, field)
+func (ec *executionContext) _SecurityIdentifier_scheme(ctx context.Context, field graphql.CollectedField, obj *model.SecurityIdentifier) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SecurityIdentifier_scheme(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6759,7 +8147,7 @@ func (ec *executionContext) _Security_securityMarkets(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SecurityMarkets, nil
+		return obj.Scheme, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6771,42 +8159,26 @@ func (ec *executionContext) _Security_securityMarkets(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.SecurityMarket)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSecurityMarket2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityMarketᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_securityMarkets(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SecurityIdentifier_scheme(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Security",
+		Object:     "SecurityIdentifier",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "securityUuid":
-				return ec.fieldContext_SecurityMarket_securityUuid(ctx, field)
-			case "marketCode":
-				return ec.fieldContext_SecurityMarket_marketCode(ctx, field)
-			case "currencyCode":
-				return ec.fieldContext_SecurityMarket_currencyCode(ctx, field)
-			case "firstPriceDate":
-				return ec.fieldContext_SecurityMarket_firstPriceDate(ctx, field)
-			case "lastPriceDate":
-				return ec.fieldContext_SecurityMarket_lastPriceDate(ctx, field)
-			case "symbol":
-				return ec.fieldContext_SecurityMarket_symbol(ctx, field)
-			case "updatePrices":
-				return ec.fieldContext_SecurityMarket_updatePrices(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SecurityMarket", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_securityTaxonomies(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_securityTaxonomies(ctx, field)
+func (ec *executionContext) _SecurityIdentifier_mic(ctx context.Context, field graphql.CollectedField, obj *model.SecurityIdentifier) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SecurityIdentifier_mic(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6819,48 +8191,35 @@ func (ec *executionContext) _Security_securityTaxonomies(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Security().SecurityTaxonomies(rctx, obj)
+		return obj.Mic, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.SecurityTaxonomy)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSecurityTaxonomy2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityTaxonomyᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_securityTaxonomies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SecurityIdentifier_mic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Security",
+		Object:     "SecurityIdentifier",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "securityUuid":
-				return ec.fieldContext_SecurityTaxonomy_securityUuid(ctx, field)
-			case "taxonomyUuid":
-				return ec.fieldContext_SecurityTaxonomy_taxonomyUuid(ctx, field)
-			case "weight":
-				return ec.fieldContext_SecurityTaxonomy_weight(ctx, field)
-			case "taxonomy":
-				return ec.fieldContext_SecurityTaxonomy_taxonomy(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SecurityTaxonomy", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Security_events(ctx context.Context, field graphql.CollectedField, obj *model.Security) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Security_events(ctx, field)
+func (ec *executionContext) _SecurityIdentifier_value(ctx context.Context, field graphql.CollectedField, obj *model.SecurityIdentifier) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SecurityIdentifier_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6873,7 +8232,7 @@ func (ec *executionContext) _Security_events(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Security().Events(rctx, obj)
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6885,31 +8244,19 @@ func (ec *executionContext) _Security_events(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Event)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Security_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SecurityIdentifier_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Security",
+		Object:     "SecurityIdentifier",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "date":
-				return ec.fieldContext_Event_date(ctx, field)
-			case "type":
-				return ec.fieldContext_Event_type(ctx, field)
-			case "amount":
-				return ec.fieldContext_Event_amount(ctx, field)
-			case "currencyCode":
-				return ec.fieldContext_Event_currencyCode(ctx, field)
-			case "ratio":
-				return ec.fieldContext_Event_ratio(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Event", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
@@ -7534,6 +8881,10 @@ func (ec *executionContext) fieldContext_Session_user(ctx context.Context, field
 				return ec.fieldContext_User_isAdmin(ctx, field)
 			case "lastSeenAt":
 				return ec.fieldContext_User_lastSeenAt(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "emailVerified":
+				return ec.fieldContext_User_emailVerified(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
@@ -8016,6 +9367,91 @@ func (ec *executionContext) fieldContext_User_lastSeenAt(ctx context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_email(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_email(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_emailVerified(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_emailVerified(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EmailVerified, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_emailVerified(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext___Directive_name(ctx, field)
 	if err != nil {
@@ -9796,7 +11232,12 @@ func (ec *executionContext) unmarshalInputPortfolioAccountInput(ctx context.Cont
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"type", "name", "currencyCode", "referenceAccountUuid", "active", "note", "updatedAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "type":
 			var err error
@@ -9867,7 +11308,12 @@ func (ec *executionContext) unmarshalInputPortfolioInput(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"name", "note", "baseCurrencyCode"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "name":
 			var err error
@@ -9906,7 +11352,12 @@ func (ec *executionContext) unmarshalInputPortfolioSecurityEventInput(ctx contex
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"date", "type", "details"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "date":
 			var err error
@@ -9945,7 +11396,12 @@ func (ec *executionContext) unmarshalInputPortfolioSecurityInput(ctx context.Con
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"name", "currencyCode", "isin", "wkn", "symbol", "active", "note", "securityUuid", "updatedAt", "calendar", "feed", "feedUrl", "latestFeed", "latestFeedUrl", "events", "properties"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "name":
 			var err error
@@ -10088,7 +11544,12 @@ func (ec *executionContext) unmarshalInputPortfolioSecurityKey(ctx context.Conte
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"portfolioId", "uuid"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "portfolioId":
 			var err error
@@ -10119,7 +11580,12 @@ func (ec *executionContext) unmarshalInputPortfolioSecurityPropertyInput(ctx con
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"name", "type", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "name":
 			var err error
@@ -10158,7 +11624,12 @@ func (ec *executionContext) unmarshalInputPortfolioTransactionInput(ctx context.
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"accountUuid", "type", "datetime", "partnerTransactionUuid", "shares", "portfolioSecurityUuid", "note", "updatedAt", "units"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "accountUuid":
 			var err error
@@ -10245,7 +11716,12 @@ func (ec *executionContext) unmarshalInputPortfolioTransactionUnitInput(ctx cont
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"type", "amount", "currencyCode", "originalAmount", "originalCurrencyCode", "exchangeRate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "type":
 			var err error
@@ -10301,6 +11777,86 @@ func (ec *executionContext) unmarshalInputPortfolioTransactionUnitInput(ctx cont
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputSecurityAliasInput(ctx context.Context, obj interface{}) (model.SecurityAlias, error) {
+	var it model.SecurityAlias
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"kind", "name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "kind":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			it.Kind, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSecurityIdentifierInput(ctx context.Context, obj interface{}) (model.SecurityIdentifier, error) {
+	var it model.SecurityIdentifier
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"scheme", "mic", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "scheme":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scheme"))
+			it.Scheme, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mic":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mic"))
+			it.Mic, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			it.Value, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSecurityInput(ctx context.Context, obj interface{}) (model.SecurityInput, error) {
 	var it model.SecurityInput
 	asMap := map[string]interface{}{}
@@ -10308,7 +11864,12 @@ func (ec *executionContext) unmarshalInputSecurityInput(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"name", "isin", "wkn", "securityType", "symbolXfra", "symbolXnas", "symbolXnys", "logoUrl", "identifiers", "aliases"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "name":
 			var err error
@@ -10374,6 +11935,22 @@ func (ec *executionContext) unmarshalInputSecurityInput(ctx context.Context, obj
 			if err != nil {
 				return it, err
 			}
+		case "identifiers":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("identifiers"))
+			it.Identifiers, err = ec.unmarshalOSecurityIdentifierInput2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifierᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "aliases":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("aliases"))
+			it.Aliases, err = ec.unmarshalOSecurityAliasInput2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAliasᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -10387,7 +11964,12 @@ func (ec *executionContext) unmarshalInputSecurityTaxonomyInput(ctx context.Cont
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"taxonomyUuid", "weight"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "taxonomyUuid":
 			var err error
@@ -10418,7 +12000,12 @@ func (ec *executionContext) unmarshalInputTaxonomyInput(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	for k, v := range asMap {
+	fieldsInOrder := [...]string{"parentUuid", "rootUuid", "name", "code"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
 		switch k {
 		case "parentUuid":
 			var err error
@@ -10466,6 +12053,80 @@ func (ec *executionContext) unmarshalInputTaxonomyInput(ctx context.Context, obj
 
 // region    **************************** object.gotpl ****************************
 
+var calendarEventImplementors = []string{"CalendarEvent"}
+
+func (ec *executionContext) _CalendarEvent(ctx context.Context, sel ast.SelectionSet, obj *model.CalendarEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, calendarEventImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CalendarEvent")
+		case "securityUuid":
+
+			out.Values[i] = ec._CalendarEvent_securityUuid(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "securityName":
+
+			out.Values[i] = ec._CalendarEvent_securityName(ctx, field, obj)
+
+		case "isin":
+
+			out.Values[i] = ec._CalendarEvent_isin(ctx, field, obj)
+
+		case "logoUrl":
+
+			out.Values[i] = ec._CalendarEvent_logoUrl(ctx, field, obj)
+
+		case "date":
+
+			out.Values[i] = ec._CalendarEvent_date(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+
+			out.Values[i] = ec._CalendarEvent_type(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "amount":
+
+			out.Values[i] = ec._CalendarEvent_amount(ctx, field, obj)
+
+		case "currencyCode":
+
+			out.Values[i] = ec._CalendarEvent_currencyCode(ctx, field, obj)
+
+		case "ratio":
+
+			out.Values[i] = ec._CalendarEvent_ratio(ctx, field, obj)
+
+		case "shares":
+
+			out.Values[i] = ec._CalendarEvent_shares(ctx, field, obj)
+
+		case "expectedIncome":
+
+			out.Values[i] = ec._CalendarEvent_expectedIncome(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var currencyImplementors = []string{"Currency"}
 
 func (ec *executionContext) _Currency(ctx context.Context, sel ast.SelectionSet, obj *model.Currency) graphql.Marshaler {
@@ -10555,6 +12216,41 @@ func (ec *executionContext) _Event(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
+var eventCalendarImplementors = []string{"EventCalendar"}
+
+func (ec *executionContext) _EventCalendar(ctx context.Context, sel ast.SelectionSet, obj *model.EventCalendar) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, eventCalendarImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EventCalendar")
+		case "entries":
+
+			out.Values[i] = ec._EventCalendar_entries(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "totalCount":
+
+			out.Values[i] = ec._EventCalendar_totalCount(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var exchangerateImplementors = []string{"Exchangerate"}
 
 func (ec *executionContext) _Exchangerate(ctx context.Context, sel ast.SelectionSet, obj *model.Exchangerate) graphql.Marshaler {
@@ -11475,6 +13171,29 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "eventCalendar":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_eventCalendar(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -11573,6 +13292,24 @@ func (ec *executionContext) _Security(ctx context.Context, sel ast.SelectionSet,
 
 			out.Values[i] = ec._Security_logoUrl(ctx, field, obj)
 
+		case "logoThumbUrl":
+
+			out.Values[i] = ec._Security_logoThumbUrl(ctx, field, obj)
+
+		case "identifiers":
+
+			out.Values[i] = ec._Security_identifiers(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "aliases":
+
+			out.Values[i] = ec._Security_aliases(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		case "securityMarkets":
 
 			out.Values[i] = ec._Security_securityMarkets(ctx, field, obj)
@@ -11596,30 +13333,104 @@ func (ec *executionContext) _Security(ctx context.Context, sel ast.SelectionSet,
 				return res
 			}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "events":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Security_events(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var securityAliasImplementors = []string{"SecurityAlias"}
+
+func (ec *executionContext) _SecurityAlias(ctx context.Context, sel ast.SelectionSet, obj *model.SecurityAlias) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, securityAliasImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SecurityAlias")
+		case "kind":
+
+			out.Values[i] = ec._SecurityAlias_kind(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+
+			out.Values[i] = ec._SecurityAlias_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var securityIdentifierImplementors = []string{"SecurityIdentifier"}
 
-			})
-		case "events":
-			field := field
+func (ec *executionContext) _SecurityIdentifier(ctx context.Context, sel ast.SelectionSet, obj *model.SecurityIdentifier) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, securityIdentifierImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SecurityIdentifier")
+		case "scheme":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Security_events(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
+			out.Values[i] = ec._SecurityIdentifier_scheme(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "mic":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			out.Values[i] = ec._SecurityIdentifier_mic(ctx, field, obj)
 
-			})
+		case "value":
+
+			out.Values[i] = ec._SecurityIdentifier_value(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11902,6 +13713,17 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 
 			out.Values[i] = ec._User_lastSeenAt(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "email":
+
+			out.Values[i] = ec._User_email(ctx, field, obj)
+
+		case "emailVerified":
+
+			out.Values[i] = ec._User_emailVerified(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -12249,6 +14071,60 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNCalendarEvent2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐCalendarEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CalendarEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCalendarEvent2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐCalendarEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCalendarEvent2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐCalendarEvent(ctx context.Context, sel ast.SelectionSet, v *model.CalendarEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CalendarEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNCurrency2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐCurrencyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Currency) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -12403,6 +14279,20 @@ func (ec *executionContext) marshalNEvent2ᚖgithubᚗcomᚋportfolioᚑreport
 	return ec._Event(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNEventCalendar2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐEventCalendar(ctx context.Context, sel ast.SelectionSet, v model.EventCalendar) graphql.Marshaler {
+	return ec._EventCalendar(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEventCalendar2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐEventCalendar(ctx context.Context, sel ast.SelectionSet, v *model.EventCalendar) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._EventCalendar(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNExchangerate2githubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐExchangerate(ctx context.Context, sel ast.SelectionSet, v model.Exchangerate) graphql.Marshaler {
 	return ec._Exchangerate(ctx, sel, &v)
 }
@@ -12992,6 +14882,124 @@ func (ec *executionContext) marshalNSecurity2ᚖgithubᚗcomᚋportfolioᚑrepor
 	return ec._Security(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSecurityAlias2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAliasᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SecurityAlias) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSecurityAlias2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAlias(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSecurityAlias2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAlias(ctx context.Context, sel ast.SelectionSet, v *model.SecurityAlias) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SecurityAlias(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSecurityAliasInput2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAlias(ctx context.Context, v interface{}) (*model.SecurityAlias, error) {
+	res, err := ec.unmarshalInputSecurityAliasInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSecurityIdentifier2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifierᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SecurityIdentifier) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSecurityIdentifier2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifier(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSecurityIdentifier2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifier(ctx context.Context, sel ast.SelectionSet, v *model.SecurityIdentifier) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SecurityIdentifier(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSecurityIdentifierInput2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifier(ctx context.Context, v interface{}) (*model.SecurityIdentifier, error) {
+	res, err := ec.unmarshalInputSecurityIdentifierInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNSecurityMarket2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityMarketᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SecurityMarket) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -13542,6 +15550,62 @@ func (ec *executionContext) marshalODecimal2ᚖgithubᚗcomᚋshopspringᚋdecim
 	return res
 }
 
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOSecurityAliasInput2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAliasᚄ(ctx context.Context, v interface{}) ([]*model.SecurityAlias, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.SecurityAlias, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSecurityAliasInput2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityAlias(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOSecurityIdentifierInput2ᚕᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifierᚄ(ctx context.Context, v interface{}) ([]*model.SecurityIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.SecurityIdentifier, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSecurityIdentifierInput2ᚖgithubᚗcomᚋportfolioᚑreportᚋprᚑapiᚋgraphᚋmodelᚐSecurityIdentifier(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
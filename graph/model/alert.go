@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Alert as used in API
+type Alert struct {
+	ID                int              `json:"id"`
+	SecurityUUID      uuid.UUID        `json:"securityUuid"`
+	MarketCode        *string          `json:"marketCode"`
+	Type              AlertType        `json:"type"`
+	Threshold         *decimal.Decimal `json:"threshold"`
+	CreatedAt         time.Time        `json:"createdAt"`
+	TriggeredAt       *time.Time       `json:"triggeredAt"`
+	NotificationError *string          `json:"notificationError"`
+}
+
+// AlertInput holds attributes to create alert
+type AlertInput struct {
+	SecurityUUID uuid.UUID        `json:"securityUuid" binding:"required"`
+	MarketCode   *string          `json:"marketCode"`
+	Type         AlertType        `json:"type" binding:"required"`
+	Threshold    *decimal.Decimal `json:"threshold"`
+}
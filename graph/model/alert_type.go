@@ -0,0 +1,43 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// AlertType represents condition of price alert
+type AlertType string
+
+const (
+	AlertTypePriceAbove    AlertType = "priceAbove"
+	AlertTypePriceBelow    AlertType = "priceBelow"
+	AlertTypePercentChange AlertType = "percentChange"
+	AlertTypeDividend      AlertType = "dividend"
+)
+
+func (t AlertType) isValid() bool {
+	switch t {
+	case AlertTypePriceAbove, AlertTypePriceBelow, AlertTypePercentChange, AlertTypeDividend:
+		return true
+	}
+	return false
+}
+
+// String returns underlying string
+func (t AlertType) String() string {
+	return string(t)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (t *AlertType) UnmarshalJSON(v []byte) error {
+	str := string(v)
+	str, err := strconv.Unquote(str)
+	if err != nil {
+		return fmt.Errorf("could not unquote string")
+	}
+	*t = AlertType(str)
+	if !t.isValid() {
+		return fmt.Errorf("%s is not a valid AlertType", str)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package model
+
+// BlobMeta holds metadata of file in BlobStore
+type BlobMeta struct {
+	ContentType  string
+	CacheControl string
+}
@@ -0,0 +1,8 @@
+package model
+
+import "github.com/google/uuid"
+
+// SecurityCacheTag returns tag of cached responses containing data of security
+func SecurityCacheTag(securityUuid uuid.UUID) string {
+	return "security:" + securityUuid.String()
+}
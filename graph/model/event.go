@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SecurityEvent is a corporate event (dividend or split) of security
+type SecurityEvent struct {
+	ID           uint      `json:"id"`
+	SecurityUUID uuid.UUID `json:"securityUuid"`
+	Date         Date      `json:"date"`
+	Type         string    `json:"type"`
+	Amount       *string   `json:"amount"`
+	CurrencyCode *string   `json:"currencyCode"`
+	Ratio        *string   `json:"ratio"`
+}
+
+// SecurityEventInput holds data to create/update event of security,
+// dividends require amount and currencyCode, splits require ratio n:m
+type SecurityEventInput struct {
+	Date         string  `json:"date" binding:"required,DateYYYY-MM-DD"`
+	Type         string  `json:"type" binding:"required,oneof=dividend split"`
+	Amount       *string `json:"amount" binding:"omitempty,numeric"`
+	CurrencyCode *string `json:"currencyCode" binding:"omitempty,len=3,uppercase"`
+	Ratio        *string `json:"ratio" binding:"omitempty,max=10"`
+}
+
+// EventCalendarQuery filters and paginates events across all securities
+type EventCalendarQuery struct {
+	From         *Date
+	To           *Date
+	Type         *string
+	Tag          *string
+	SecurityType *string
+	Limit        int
+	Skip         int
+}
+
+// CalendarEvent is event joined with its security, shares and expectedIncome
+// are only set for events of securities held in portfolio
+type CalendarEvent struct {
+	SecurityUUID   uuid.UUID        `json:"securityUuid"`
+	SecurityName   *string          `json:"securityName"`
+	Isin           *string          `json:"isin"`
+	LogoURL        *string          `json:"logoUrl"`
+	Date           Date             `json:"date"`
+	Type           string           `json:"type"`
+	Amount         *string          `json:"amount"`
+	CurrencyCode   *string          `json:"currencyCode"`
+	Ratio          *string          `json:"ratio"`
+	Shares         *decimal.Decimal `json:"shares"`
+	ExpectedIncome *decimal.Decimal `json:"expectedIncome"` // in base currency of portfolio
+}
+
+// EventCalendar is one page of calendar events
+type EventCalendar struct {
+	Entries    []*CalendarEvent `json:"entries"`
+	TotalCount int              `json:"totalCount"`
+}
@@ -11,15 +11,35 @@ import (
 	"gorm.io/datatypes"
 )
 
+// AlertService describes the interface of alert service
+type AlertService interface {
+	GetAlertsOfUser(user *User) []*Alert
+	CreateAlert(user *User, input *AlertInput) (*Alert, error)
+	DeleteAlert(user *User, ID uint) (*Alert, error)
+	EvaluateAlerts() error
+}
+
 // CurrenciesService describes the interface of currencies service
 type CurrenciesService interface {
 	GetCurrencies() []*Currency
+	GetCurrenciesLastModified() (time.Time, int64)
 	GetExchangerate(baseCC, quoteCC string) (*Exchangerate, error)
 	GetExchangeratePrices(exchangerateID uint, from *string) ([]*ExchangeratePrice, error)
 	ConvertCurrencyAmount(decimal.Decimal, string, string, time.Time) (decimal.Decimal, error)
 	UpdateExchangeRates() error
 }
 
+// MarketService describes the interface of market service
+type MarketService interface {
+	GetMarkets() []*Market
+	GetMarket(code string) (*Market, error)
+	CreateMarket(input *MarketInput) (*Market, error)
+	UpdateMarket(code string, input *MarketInput) (*Market, error)
+	DeleteMarket(code string) (*Market, error)
+	GetMarketCalendar(marketCode string, year int) (*MarketCalendar, error)
+	GetTradingDays(marketCode string, from, to time.Time) []time.Time
+}
+
 // GeoipService describes the interface of GeoIP service
 type GeoipService interface {
 	GetCountryFromIp(string) string
@@ -28,6 +48,7 @@ type GeoipService interface {
 // MailerService describes the interface of mailer service
 type MailerService interface {
 	SendContactMail(senderEmail string, senderName string, subject string, message string, ip string) error
+	SendMail(recipientEmail string, subject string, templateName string, data any) error
 }
 
 // PortfolioService describes the interface of portfolio service
@@ -38,6 +59,7 @@ type PortfolioService interface {
 	CreatePortfolio(user *User, req *PortfolioInput) (*Portfolio, error)
 	UpdatePortfolio(ID uint, req *PortfolioInput) (*Portfolio, error)
 	DeletePortfolio(ID uint) *Portfolio
+	ClonePortfolio(user *User, ID uint, input *PortfolioCloneInput) (*Portfolio, error)
 
 	GetPortfolioAccountsOfPortfolio(portfolioId int) []*PortfolioAccount
 	UpsertPortfolioAccount(portfolioId int, uuid uuid.UUID, input PortfolioAccountInput) (*PortfolioAccount, error)
@@ -47,28 +69,84 @@ type PortfolioService interface {
 	UpsertPortfolioSecurity(portfolioId int, uuid uuid.UUID, input PortfolioSecurityInput) (*PortfolioSecurity, error)
 	DeletePortfolioSecurity(portfolioId int, uuid uuid.UUID) (*PortfolioSecurity, error)
 	CalcSecurityShares(securities []PortfolioSecurityKey) []*decimal.Decimal
+	GetPortfolioAllocation(portfolioId int, rootTaxonomyUuid uuid.UUID, lookThrough bool) (*PortfolioAllocation, error)
 
 	GetPortfolioTransactionsOfPortfolio(portfolioId int) []*PortfolioTransaction
 	UpsertPortfolioTransaction(portfolioId int, uuid uuid.UUID, input PortfolioTransactionInput) (*PortfolioTransaction, error)
 	DeletePortfolioTransaction(portfolioId int, uuid uuid.UUID) (*PortfolioTransaction, error)
 }
 
+// PriceProvider describes the interface of a source of security prices
+type PriceProvider interface {
+	Name() string
+	GetPrices(ctx context.Context, req *PriceRequest) ([]*SecurityPrice, error)
+}
+
+// BlobStore describes the interface of a storage of files, e.g. logos
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, meta BlobMeta) error
+	URL(key string) string
+}
+
+// CacheService describes the interface of cache of responses, entries are
+// tagged to invalidate them when underlying data changes
+type CacheService interface {
+	Middleware(tags func(c *gin.Context) []string) gin.HandlerFunc
+	Fetch(uri string, tags []string, load func() ([]byte, error)) ([]byte, error)
+	InvalidateTags(tags ...string)
+	Purge()
+}
+
+// EventService describes the interface of event service
+type EventService interface {
+	GetSecurityEvents(securityUuid uuid.UUID) ([]*SecurityEvent, error)
+	CreateSecurityEvent(securityUuid uuid.UUID, input *SecurityEventInput) (*SecurityEvent, error)
+	UpdateSecurityEvent(securityUuid uuid.UUID, id uint, input *SecurityEventInput) (*SecurityEvent, error)
+	DeleteSecurityEvent(securityUuid uuid.UUID, id uint) (*SecurityEvent, error)
+	UpsertSecurityEvents(securityUuid uuid.UUID, inputs []*SecurityEventInput) ([]*SecurityEvent, error)
+	GetEventCalendar(query *EventCalendarQuery, portfolio *Portfolio) *EventCalendar
+}
+
+// PriceService describes the interface of price service
+type PriceService interface {
+	RegisterPriceProvider(marketCode string, provider PriceProvider)
+	GetPriceProvider(marketCode string) (PriceProvider, bool)
+	UpdatePrices() error
+	StartPriceUpdate() error
+	GetPriceUpdateStatus(onlyFailed bool) []*PriceUpdateStatus
+	GetPriceAdjustmentFactors(securityUUID, marketCode string, adjustment PriceAdjustment) ([]*PriceAdjustmentFactor, error)
+	InvalidatePriceAdjustments(securityUUID string)
+	UpdateSecurityMarketPrices(securityUUID uuid.UUID, marketCode string, prices []*SecurityPrice) error
+	ImportPrices(r io.Reader, format PriceImportFormat) (*PriceImportResult, error)
+	CheckPriceQuality(options *PriceCheckOptions) (*PriceCheckResult, error)
+	GetPriceFindings(query *PriceFindingsQuery) *PriceFindings
+	AcknowledgePriceFinding(id uint, user *User, note *string) (*PriceFinding, error)
+	UnacknowledgePriceFinding(id uint) (*PriceFinding, error)
+}
+
 // SecurityService describes the interface of security service
 type SecurityService interface {
 	GetSecurityByUUID(uuid uuid.UUID) (*Security, error)
 	GetSecuritiesByTag(tag string) []*Security
 	GetEventsOfSecurity(security *Security) []*Event
-	CreateSecurity(input *SecurityInput) (*Security, error)
-	UpdateSecurity(uuid uuid.UUID, input *SecurityInput) (*Security, error)
-	DeleteSecurity(uuid uuid.UUID) (*Security, error)
-	UpdateLogo(uuid uuid.UUID, logo io.Reader, extension string) (string, error)
-	DeleteLogo(uuid uuid.UUID) error
-	DeleteSecurityMarket(securityUuid uuid.UUID, marketCode string) (*SecurityMarket, error)
-	UpdateSecurityTaxonomies(securityUuid, rootTaxonomyUuid uuid.UUID, inputs []*SecurityTaxonomyInput) ([]*SecurityTaxonomy, error)
-	UpsertTag(name string, securityUuids []uuid.UUID) ([]*Security, error)
-	DeleteTag(name string)
-	FindGapsInPrices(minDuration, maxResults int) []map[string]interface{}
-	LogoUrlFromExtras(extrasJson datatypes.JSON) *string
+	CreateSecurity(input *SecurityInput, user *User) (*Security, error)
+	UpdateSecurity(uuid uuid.UUID, input *SecurityInput, user *User) (*Security, error)
+	DeleteSecurity(uuid uuid.UUID, user *User) (*Security, error)
+	UpdateLogo(uuid uuid.UUID, logo io.Reader, user *User) (*SecurityLogo, error)
+	DeleteLogo(uuid uuid.UUID, user *User) error
+	UpdateSecurityMarket(securityUuid uuid.UUID, marketCode string, input *SecurityMarketInput, user *User) (*SecurityMarket, error)
+	DeleteSecurityMarket(securityUuid uuid.UUID, marketCode string, user *User) (*SecurityMarket, error)
+	UpdateSecurityTaxonomies(securityUuid, rootTaxonomyUuid uuid.UUID, inputs []*SecurityTaxonomyInput, user *User) ([]*SecurityTaxonomy, error)
+	UpsertTag(name string, securityUuids []uuid.UUID, user *User) ([]*Security, error)
+	DeleteTag(name string, user *User)
+	GetSecurityHistory(uuid uuid.UUID) ([]*SecurityChange, error)
+	RevertSecurity(uuid uuid.UUID, version int, user *User) (*Security, error)
+	MergeSecurities(canonicalUuid, duplicateUuid uuid.UUID, prefer SecurityMergePreference, user *User) (*Security, error)
+	ResolveSecurityUUID(uuid uuid.UUID) uuid.UUID
+	GetSecurityHoldings(uuid uuid.UUID) (*SecurityHoldings, error)
+	UpdateSecurityHoldings(uuid uuid.UUID, r io.Reader, format SecurityHoldingsFormat, asOf *Date) (*SecurityHoldings, error)
+	FindGapsInPrices(query *PriceGapsQuery) (*PriceGaps, error)
+	LogoFromExtras(extrasJson datatypes.JSON) SecurityLogo
 }
 
 // SessionService describes the interface of session service
@@ -99,6 +177,9 @@ type UserService interface {
 	GetUserFromSession(session *Session) (*User, error)
 	UpdatePassword(ctx context.Context, user *User, password string) error
 	VerifyPassword(ctx context.Context, user *User, password string) (bool, error)
+	UpdateEmail(ctx context.Context, user *User, email string) (string, error)
+	VerifyEmail(ctx context.Context, user *User, token string) error
+	CancelEmailVerification(ctx context.Context, user *User, token string) error
 	Delete(id int) error
 	UpdateLastSeen(user *User) error
 }
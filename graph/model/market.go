@@ -0,0 +1,43 @@
+package model
+
+// Market is a trading venue of securities
+type Market struct {
+	Code         string  `json:"code"`
+	Name         string  `json:"name"`
+	Mic          *string `json:"mic"`
+	CountryCode  *string `json:"countryCode"`
+	Timezone     *string `json:"timezone"`
+	CurrencyCode *string `json:"currencyCode"`
+	OpenTime     *string `json:"openTime"`
+	CloseTime    *string `json:"closeTime"`
+	Calendar     *string `json:"calendar"`
+}
+
+// MarketInput holds data to create/update market
+type MarketInput struct {
+	Code         string  `json:"code" binding:"required,max=20"`
+	Name         string  `json:"name" binding:"required"`
+	Mic          *string `json:"mic" binding:"omitempty,len=4,alphanum,uppercase"`
+	CountryCode  *string `json:"countryCode" binding:"omitempty,iso3166_1_alpha2"`
+	Timezone     *string `json:"timezone"`
+	CurrencyCode *string `json:"currencyCode" binding:"omitempty,len=3"`
+	OpenTime     *string `json:"openTime" binding:"omitempty,datetime=15:04"`
+	CloseTime    *string `json:"closeTime" binding:"omitempty,datetime=15:04"`
+	Calendar     *string `json:"calendar"`
+}
+
+// MarketCalendar lists trading days and holidays of market in one year
+type MarketCalendar struct {
+	MarketCode   string           `json:"marketCode"`
+	Calendar     string           `json:"calendar"`
+	CalendarName string           `json:"calendarName"`
+	Year         int              `json:"year"`
+	TradingDays  int              `json:"tradingDays"`
+	Holidays     []*MarketHoliday `json:"holidays"`
+}
+
+// MarketHoliday is a day without trading
+type MarketHoliday struct {
+	Date Date   `json:"date"`
+	Name string `json:"name"`
+}
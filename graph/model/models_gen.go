@@ -181,29 +181,34 @@ type PortfolioTransactionUnitInput struct {
 }
 
 type Security struct {
-	UUID               uuid.UUID           `json:"uuid"`
-	Name               *string             `json:"name"`
-	Isin               *string             `json:"isin"`
-	Wkn                *string             `json:"wkn"`
-	SecurityType       *string             `json:"securityType"`
-	SymbolXfra         *string             `json:"symbolXfra"`
-	SymbolXnas         *string             `json:"symbolXnas"`
-	SymbolXnys         *string             `json:"symbolXnys"`
-	LogoURL            *string             `json:"logoUrl"`
-	SecurityMarkets    []*SecurityMarket   `json:"securityMarkets"`
-	SecurityTaxonomies []*SecurityTaxonomy `json:"securityTaxonomies"`
-	Events             []*Event            `json:"events"`
+	UUID               uuid.UUID             `json:"uuid"`
+	Name               *string               `json:"name"`
+	Isin               *string               `json:"isin"`
+	Wkn                *string               `json:"wkn"`
+	SecurityType       *string               `json:"securityType"`
+	SymbolXfra         *string               `json:"symbolXfra"`
+	SymbolXnas         *string               `json:"symbolXnas"`
+	SymbolXnys         *string               `json:"symbolXnys"`
+	LogoURL            *string               `json:"logoUrl"`
+	LogoThumbURL       *string               `json:"logoThumbUrl"`
+	Identifiers        []*SecurityIdentifier `json:"identifiers"`
+	Aliases            []*SecurityAlias      `json:"aliases"`
+	SecurityMarkets    []*SecurityMarket     `json:"securityMarkets"`
+	SecurityTaxonomies []*SecurityTaxonomy   `json:"securityTaxonomies"`
+	Events             []*Event              `json:"events"`
 }
 
 type SecurityInput struct {
-	Name         *string `json:"name"`
-	Isin         *string `json:"isin"`
-	Wkn          *string `json:"wkn"`
-	SecurityType *string `json:"securityType"`
-	SymbolXfra   *string `json:"symbolXfra"`
-	SymbolXnas   *string `json:"symbolXnas"`
-	SymbolXnys   *string `json:"symbolXnys"`
-	LogoURL      *string `json:"logoUrl"`
+	Name         *string               `json:"name"`
+	Isin         *string               `json:"isin"`
+	Wkn          *string               `json:"wkn"`
+	SecurityType *string               `json:"securityType"`
+	SymbolXfra   *string               `json:"symbolXfra"`
+	SymbolXnas   *string               `json:"symbolXnas"`
+	SymbolXnys   *string               `json:"symbolXnys"`
+	LogoURL      *string               `json:"logoUrl"`
+	Identifiers  []*SecurityIdentifier `json:"identifiers"`
+	Aliases      []*SecurityAlias      `json:"aliases"`
 }
 
 type SecurityMarket struct {
@@ -244,8 +249,10 @@ type TaxonomyInput struct {
 }
 
 type User struct {
-	ID         int    `json:"id"`
-	Username   string `json:"username"`
-	IsAdmin    bool   `json:"isAdmin"`
-	LastSeenAt string `json:"lastSeenAt"`
+	ID            int     `json:"id"`
+	Username      string  `json:"username"`
+	IsAdmin       bool    `json:"isAdmin"`
+	LastSeenAt    string  `json:"lastSeenAt"`
+	Email         *string `json:"email"`
+	EmailVerified bool    `json:"emailVerified"`
 }
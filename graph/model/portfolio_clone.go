@@ -0,0 +1,7 @@
+package model
+
+// PortfolioCloneInput holds options to clone portfolio
+type PortfolioCloneInput struct {
+	Name             *string `json:"name"`
+	SkipTransactions bool    `json:"skipTransactions"`
+}
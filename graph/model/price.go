@@ -0,0 +1,87 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PriceRequest identifies prices of security market to be retrieved from price provider
+type PriceRequest struct {
+	SecurityUUID uuid.UUID
+	Isin         *string
+	MarketCode   string
+	Symbol       *string
+	CurrencyCode string
+	From         *Date // nil requests all available prices
+}
+
+// SecurityPrice is price of security at date, open/high/low/volume are optional
+type SecurityPrice struct {
+	Date   Date
+	Close  decimal.Decimal
+	Open   *decimal.Decimal
+	High   *decimal.Decimal
+	Low    *decimal.Decimal
+	Volume *int64
+}
+
+// PriceUpdateStatus holds result of last price update of security market
+type PriceUpdateStatus struct {
+	SecurityUUID        uuid.UUID  `json:"securityUuid"`
+	MarketCode          string     `json:"marketCode"`
+	LastPriceDate       *Date      `json:"lastPriceDate"`
+	PriceUpdateAt       *time.Time `json:"priceUpdateAt"`
+	PriceUpdateError    *string    `json:"priceUpdateError"`
+	PriceUpdateFailures int        `json:"priceUpdateFailures"`
+}
+
+// PriceAdjustment selects how historical prices are back-adjusted for events
+type PriceAdjustment string
+
+const (
+	// PriceAdjustmentSplits adjusts prices for splits only
+	PriceAdjustmentSplits PriceAdjustment = "splits"
+	// PriceAdjustmentTotal adjusts prices for splits and dividends (total return)
+	PriceAdjustmentTotal PriceAdjustment = "total"
+)
+
+// IsValid checks if adjustment is known
+func (a PriceAdjustment) IsValid() bool {
+	return a == PriceAdjustmentSplits || a == PriceAdjustmentTotal
+}
+
+// PriceAdjustmentFactor holds cumulative factors of all events at or after Date,
+// prices before Date are multiplied by Factor and volumes by VolumeFactor
+type PriceAdjustmentFactor struct {
+	Date         Date
+	Factor       decimal.Decimal
+	VolumeFactor decimal.Decimal
+}
+
+// PriceImportFormat is format of prices in bulk import
+type PriceImportFormat string
+
+const (
+	// PriceImportFormatCSV is CSV with header securityUuid,marketCode,date,close
+	// and optionally open,high,low,volume
+	PriceImportFormatCSV PriceImportFormat = "csv"
+	// PriceImportFormatNDJSON is one JSON object per line with same fields as CSV
+	PriceImportFormatNDJSON PriceImportFormat = "ndjson"
+)
+
+// PriceImportResult summarizes bulk import of prices, only the first errors are listed
+type PriceImportResult struct {
+	// Imported counts prices, rows of same market and date within one batch count once
+	Imported   int                 `json:"imported"`
+	Markets    int                 `json:"markets"`
+	ErrorCount int                 `json:"errorCount"`
+	Errors     []*PriceImportError `json:"errors"`
+}
+
+// PriceImportError describes invalid row in bulk import of prices
+type PriceImportError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PriceFindingType is kind of suspicious price data
+type PriceFindingType string
+
+const (
+	// PriceFindingTypeJump is a change of close to previous close beyond threshold without split
+	PriceFindingTypeJump PriceFindingType = "jump"
+	// PriceFindingTypeNonPositive is a close of zero or less
+	PriceFindingTypeNonPositive PriceFindingType = "nonPositive"
+	// PriceFindingTypeStale is a series with updatePrices but without recent prices
+	PriceFindingTypeStale PriceFindingType = "stale"
+	// PriceFindingTypePlateau is a series of identical closes on consecutive price dates
+	PriceFindingTypePlateau PriceFindingType = "plateau"
+)
+
+// IsValid checks if type of finding is known
+func (t PriceFindingType) IsValid() bool {
+	switch t {
+	case PriceFindingTypeJump, PriceFindingTypeNonPositive, PriceFindingTypeStale, PriceFindingTypePlateau:
+		return true
+	}
+	return false
+}
+
+// PriceCheckOptions holds thresholds of price data quality checks
+type PriceCheckOptions struct {
+	// JumpThreshold is relative change of close to previous close, e.g. 0.5 for +50%/-33%
+	JumpThreshold decimal.Decimal `json:"jumpThreshold"`
+	// StaleDays is number of trading days without prices until series is stale
+	StaleDays int `json:"staleDays"`
+	// PlateauDays is number of identical closes until series is a plateau
+	PlateauDays int `json:"plateauDays"`
+}
+
+// PriceCheckResult summarizes run of price data quality checks
+type PriceCheckResult struct {
+	Detected map[PriceFindingType]int `json:"detected"`
+	New      int                      `json:"new"`
+	Resolved int                      `json:"resolved"`
+}
+
+// PriceFindingsQuery holds filters and pagination of findings
+type PriceFindingsQuery struct {
+	Type         PriceFindingType
+	MarketCode   string
+	SecurityUUID *uuid.UUID
+	Acknowledged *bool
+	Limit        int
+	Skip         int
+}
+
+// PriceFinding is suspicious price data of security market
+type PriceFinding struct {
+	ID             uint             `json:"id"`
+	SecurityUUID   uuid.UUID        `json:"securityUuid"`
+	MarketCode     string           `json:"marketCode"`
+	Type           PriceFindingType `json:"type"`
+	Date           Date             `json:"date"`
+	Details        string           `json:"details"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	DetectedAt     time.Time        `json:"detectedAt"`
+	AcknowledgedAt *time.Time       `json:"acknowledgedAt"`
+	AcknowledgedBy *string          `json:"acknowledgedBy"`
+	Note           *string          `json:"note"`
+}
+
+// PriceFindings holds one page of findings
+type PriceFindings struct {
+	Entries    []*PriceFinding
+	TotalCount int
+}
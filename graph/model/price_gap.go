@@ -0,0 +1,31 @@
+package model
+
+import "github.com/google/uuid"
+
+// PriceGapsQuery holds filters and pagination of gaps in prices
+type PriceGapsQuery struct {
+	MinDuration  int
+	MarketCode   string
+	SecurityType string
+	Limit        int
+	Skip         int
+	Cursor       string
+	// TotalCount requests total number of gaps, which requires a scan of all prices
+	TotalCount bool
+}
+
+// PriceGap is a range of trading days without prices
+type PriceGap struct {
+	SecurityUUID uuid.UUID `json:"securityUuid"`
+	MarketCode   string    `json:"marketCode"`
+	FromDate     Date      `json:"fromDate"`
+	ToDate       Date      `json:"toDate"`
+	Duration     int       `json:"duration"`
+}
+
+// PriceGaps holds one page of gaps in prices
+type PriceGaps struct {
+	Entries    []*PriceGap
+	TotalCount *int
+	NextCursor *string
+}
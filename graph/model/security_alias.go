@@ -0,0 +1,27 @@
+package model
+
+// SecurityAliasKind is kind of alternative name of security
+type SecurityAliasKind string
+
+const (
+	SecurityAliasKindFormer       SecurityAliasKind = "former"
+	SecurityAliasKindLocal        SecurityAliasKind = "local"
+	SecurityAliasKindAbbreviation SecurityAliasKind = "abbreviation"
+	SecurityAliasKindOther        SecurityAliasKind = "other"
+)
+
+// IsValid checks if kind is known
+func (k SecurityAliasKind) IsValid() bool {
+	switch k {
+	case SecurityAliasKindFormer, SecurityAliasKindLocal, SecurityAliasKindAbbreviation, SecurityAliasKindOther:
+		return true
+	}
+	return false
+}
+
+// SecurityAlias is alternative name of security, e.g. former name of renamed
+// company, name in local language or abbreviation
+type SecurityAlias struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
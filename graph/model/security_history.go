@@ -0,0 +1,72 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SecurityChangeAction is kind of change of master data of security
+type SecurityChangeAction string
+
+const (
+	SecurityChangeActionCreate           SecurityChangeAction = "create"
+	SecurityChangeActionUpdate           SecurityChangeAction = "update"
+	SecurityChangeActionDelete           SecurityChangeAction = "delete"
+	SecurityChangeActionUpdateLogo       SecurityChangeAction = "updateLogo"
+	SecurityChangeActionDeleteLogo       SecurityChangeAction = "deleteLogo"
+	SecurityChangeActionUpdateMarket     SecurityChangeAction = "updateMarket"
+	SecurityChangeActionDeleteMarket     SecurityChangeAction = "deleteMarket"
+	SecurityChangeActionUpdateTaxonomies SecurityChangeAction = "updateTaxonomies"
+	SecurityChangeActionUpdateTag        SecurityChangeAction = "updateTag"
+	SecurityChangeActionDeleteTag        SecurityChangeAction = "deleteTag"
+	SecurityChangeActionRevert           SecurityChangeAction = "revert"
+	SecurityChangeActionMerge            SecurityChangeAction = "merge"
+)
+
+// SecuritySnapshot is state of master data of security, prices and events are not included
+type SecuritySnapshot struct {
+	Name         *string                     `json:"name"`
+	Isin         *string                     `json:"isin"`
+	Wkn          *string                     `json:"wkn"`
+	SecurityType *string                     `json:"securityType"`
+	Identifiers  []*SecurityIdentifier       `json:"identifiers"`
+	Aliases      []*SecurityAlias            `json:"aliases"`
+	Extras       json.RawMessage             `json:"extras"`
+	Markets      []*SecuritySnapshotMarket   `json:"markets"`
+	Taxonomies   []*SecuritySnapshotTaxonomy `json:"taxonomies"`
+	Tags         []string                    `json:"tags"`
+}
+
+// SecuritySnapshotMarket is state of market of security
+type SecuritySnapshotMarket struct {
+	MarketCode   string  `json:"marketCode"`
+	CurrencyCode string  `json:"currencyCode"`
+	Symbol       *string `json:"symbol"`
+	UpdatePrices bool    `json:"updatePrices"`
+}
+
+// SecuritySnapshotTaxonomy is state of taxonomy of security
+type SecuritySnapshotTaxonomy struct {
+	TaxonomyUUID uuid.UUID       `json:"taxonomyUuid"`
+	Weight       decimal.Decimal `json:"weight"`
+}
+
+// SecurityChange is entry in history of security with state before change,
+// Previous is nil if security did not exist before
+type SecurityChange struct {
+	Version   int                  `json:"version"`
+	Action    SecurityChangeAction `json:"action"`
+	Previous  *SecuritySnapshot    `json:"previous"`
+	ChangedBy *string              `json:"changedBy"`
+	ChangedAt time.Time            `json:"changedAt"`
+}
+
+// SecurityMarketInput holds attributes of market of security, nil values are kept
+type SecurityMarketInput struct {
+	CurrencyCode *string
+	Symbol       *string
+	UpdatePrices *bool
+}
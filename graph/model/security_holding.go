@@ -0,0 +1,53 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SecurityHolding is constituent of fund with weight in percent, SecurityUUID is
+// nil if constituent is not in master data
+type SecurityHolding struct {
+	SecurityUUID *uuid.UUID      `json:"securityUuid"`
+	Name         *string         `json:"name"`
+	Isin         *string         `json:"isin"`
+	Weight       decimal.Decimal `json:"weight"`
+}
+
+// SecurityHoldings are constituents of fund as of date, sorted by weight
+type SecurityHoldings struct {
+	AsOf    *Date              `json:"asOf"`
+	Entries []*SecurityHolding `json:"entries"`
+}
+
+// SecurityHoldingsFormat is format of holdings in upload
+type SecurityHoldingsFormat string
+
+const (
+	// SecurityHoldingsFormatCSV is CSV with header weight and at least one of
+	// securityUuid, isin and name
+	SecurityHoldingsFormatCSV SecurityHoldingsFormat = "csv"
+	// SecurityHoldingsFormatJSON is object with asOf and holdings with same fields as CSV
+	SecurityHoldingsFormatJSON SecurityHoldingsFormat = "json"
+)
+
+// PortfolioAllocation is value of holdings of portfolio allocated to taxonomies
+// of root taxonomy. With look-through, funds are allocated by their constituents.
+type PortfolioAllocation struct {
+	RootTaxonomyUUID uuid.UUID                   `json:"rootTaxonomyUuid"`
+	CurrencyCode     string                      `json:"currencyCode"`
+	LookThrough      bool                        `json:"lookThrough"`
+	TotalValue       decimal.Decimal             `json:"totalValue"`
+	Entries          []*PortfolioAllocationEntry `json:"entries"`
+	// UnvaluedSecurities are portfolio securities held without price in master data
+	UnvaluedSecurities []uuid.UUID `json:"unvaluedSecurities"`
+}
+
+// PortfolioAllocationEntry is value allocated to taxonomy, TaxonomyUUID is nil
+// for value not classified. Weight is percentage of total value.
+type PortfolioAllocationEntry struct {
+	TaxonomyUUID *uuid.UUID      `json:"taxonomyUuid"`
+	Name         *string         `json:"name"`
+	Value        decimal.Decimal `json:"value"`
+	Weight       decimal.Decimal `json:"weight"`
+}
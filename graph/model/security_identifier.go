@@ -0,0 +1,35 @@
+package model
+
+// SecurityIdentifierScheme is kind of identifier of security. Primary ISIN and WKN
+// are attributes of security, identifiers of these schemes are further codes,
+// e.g. of merged securities.
+type SecurityIdentifierScheme string
+
+const (
+	SecurityIdentifierSchemeIsin   SecurityIdentifierScheme = "isin"
+	SecurityIdentifierSchemeWkn    SecurityIdentifierScheme = "wkn"
+	SecurityIdentifierSchemeFigi   SecurityIdentifierScheme = "figi"
+	SecurityIdentifierSchemeCusip  SecurityIdentifierScheme = "cusip"
+	SecurityIdentifierSchemeSedol  SecurityIdentifierScheme = "sedol"
+	SecurityIdentifierSchemeValor  SecurityIdentifierScheme = "valor"
+	SecurityIdentifierSchemeTicker SecurityIdentifierScheme = "ticker"
+)
+
+// IsValid checks if scheme is known
+func (s SecurityIdentifierScheme) IsValid() bool {
+	switch s {
+	case SecurityIdentifierSchemeIsin, SecurityIdentifierSchemeWkn, SecurityIdentifierSchemeFigi,
+		SecurityIdentifierSchemeCusip, SecurityIdentifierSchemeSedol, SecurityIdentifierSchemeValor,
+		SecurityIdentifierSchemeTicker:
+		return true
+	}
+	return false
+}
+
+// SecurityIdentifier identifies security within scheme, tickers are identified
+// by market (ISO 10383 MIC) in addition
+type SecurityIdentifier struct {
+	Scheme string  `json:"scheme"`
+	Mic    *string `json:"mic"`
+	Value  string  `json:"value"`
+}
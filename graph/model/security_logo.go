@@ -0,0 +1,7 @@
+package model
+
+// SecurityLogo holds absolute URLs of logo and its thumbnail
+type SecurityLogo struct {
+	LogoURL      *string `json:"logoUrl"`
+	LogoThumbURL *string `json:"logoThumbUrl"`
+}
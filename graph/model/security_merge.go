@@ -0,0 +1,17 @@
+package model
+
+// SecurityMergePreference selects which prices are kept if canonical and
+// duplicate security have prices of same market and date
+type SecurityMergePreference string
+
+const (
+	// SecurityMergePreferenceCanonical keeps prices of canonical security
+	SecurityMergePreferenceCanonical SecurityMergePreference = "canonical"
+	// SecurityMergePreferenceDuplicate replaces prices of canonical security by prices of duplicate
+	SecurityMergePreferenceDuplicate SecurityMergePreference = "duplicate"
+)
+
+// IsValid checks if preference is known
+func (p SecurityMergePreference) IsValid() bool {
+	return p == SecurityMergePreferenceCanonical || p == SecurityMergePreferenceDuplicate
+}
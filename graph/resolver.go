@@ -15,4 +15,5 @@ type Resolver struct {
 	model.PortfolioService
 	model.CurrenciesService
 	model.SecurityService
+	model.EventService
 }
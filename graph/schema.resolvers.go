@@ -210,6 +210,38 @@ func (r *queryResolver) Security(ctx context.Context, uuid uuid.UUID) (*model.Se
 	return security, nil
 }
 
+func (r *queryResolver) EventCalendar(ctx context.Context, from *model.Date, to *model.Date, typeArg *string, tag *string, securityType *string, portfolioID *int, limit *int, skip *int) (*model.EventCalendar, error) {
+	query := &model.EventCalendarQuery{
+		From:         from,
+		To:           to,
+		Type:         typeArg,
+		Tag:          tag,
+		SecurityType: securityType,
+	}
+	if limit != nil {
+		query.Limit = *limit
+	}
+	if skip != nil {
+		query.Skip = *skip
+	}
+
+	var portfolio *model.Portfolio
+	if portfolioID != nil {
+		user := middleware.UserFromContext(ctx)
+		if user == nil {
+			return nil, fmt.Errorf("Access denied")
+		}
+
+		var err error
+		portfolio, err = r.PortfolioService.GetPortfolioOfUserByID(user, uint(*portfolioID))
+		if err != nil {
+			return nil, fmt.Errorf("Not found")
+		}
+	}
+
+	return r.EventService.GetEventCalendar(query, portfolio), nil
+}
+
 func (r *queryResolver) Sessions(ctx context.Context) ([]*model.Session, error) {
 	user := middleware.UserFromContext(ctx)
 	if user == nil {
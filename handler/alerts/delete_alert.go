@@ -0,0 +1,29 @@
+package alerts
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// DeleteAlert removes alert of current user
+func (h *alertsHandler) DeleteAlert(c *gin.Context) {
+	user := middleware.UserFromContext(c.Request.Context())
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	alert, err := h.AlertService.DeleteAlert(user, uint(id))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, alert)
+}
@@ -0,0 +1,15 @@
+package alerts
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+)
+
+// GetAlerts lists all alerts of current user
+func (h *alertsHandler) GetAlerts(c *gin.Context) {
+	user := middleware.UserFromContext(c.Request.Context())
+	alerts := h.AlertService.GetAlertsOfUser(user)
+	c.JSON(http.StatusOK, alerts)
+}
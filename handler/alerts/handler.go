@@ -0,0 +1,39 @@
+package alerts
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+)
+
+type alertsHandler struct {
+	model.SessionService
+	model.UserService
+	model.AlertService
+}
+
+// NewHandler creates new alerts handler and registers routes
+func NewHandler(
+	R *gin.RouterGroup,
+	SessionService model.SessionService,
+	UserService model.UserService,
+	AlertService model.AlertService,
+) {
+	h := &alertsHandler{
+		SessionService: SessionService,
+		UserService:    UserService,
+		AlertService:   AlertService,
+	}
+
+	g := R.Group("/alerts")
+
+	g.GET("/",
+		middleware.RequireUser(SessionService, UserService),
+		h.GetAlerts)
+	g.POST("/",
+		middleware.RequireUser(SessionService, UserService),
+		h.PostAlert)
+	g.DELETE("/:id",
+		middleware.RequireUser(SessionService, UserService),
+		h.DeleteAlert)
+}
@@ -0,0 +1,29 @@
+package alerts
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// PostAlert creates new alert for current user
+func (h *alertsHandler) PostAlert(c *gin.Context) {
+	user := middleware.UserFromContext(c.Request.Context())
+
+	var input model.AlertInput
+	if err := c.BindJSON(&input); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	alert, err := h.AlertService.CreateAlert(user, &input)
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, alert)
+}
@@ -10,6 +10,7 @@ import (
 type authHandler struct {
 	model.SessionService
 	model.UserService
+	model.MailerService
 	*validator.Validate
 }
 
@@ -19,10 +20,12 @@ func NewHandler(
 	Validate *validator.Validate,
 	SessionService model.SessionService,
 	UserService model.UserService,
+	MailerService model.MailerService,
 ) {
 	h := &authHandler{
 		SessionService: SessionService,
 		UserService:    UserService,
+		MailerService:  MailerService,
 		Validate:       Validate,
 	}
 
@@ -35,6 +38,8 @@ func NewHandler(
 	g.GET("/users/me", middleware.RequireUser(SessionService, UserService), h.GetMe)
 	g.DELETE("/users/me", middleware.RequireUser(SessionService, UserService), h.DeleteMe)
 	g.POST("/users/me/password", middleware.RequireUser(SessionService, UserService), h.UpdatePassword)
+	g.POST("/users/me/email", middleware.RequireUser(SessionService, UserService), h.UpdateEmail)
+	g.POST("/users/me/email/verification", middleware.RequireUser(SessionService, UserService), h.VerifyEmail)
 
 	g.GET("/sessions", middleware.RequireUser(SessionService, UserService), h.GetSessions)
 }
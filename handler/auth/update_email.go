@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+	"github.com/portfolio-report/pr-api/service"
+)
+
+type updateEmailRequest struct {
+	Email string `json:"email" binding:"required,email,max=255"`
+}
+
+// UpdateEmail sets email address of current user and sends verification code to it
+func (h *authHandler) UpdateEmail(c *gin.Context) {
+	user := middleware.UserFromContext(c.Request.Context())
+
+	var request updateEmailRequest
+	if err := c.BindJSON(&request); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	if h.MailerService == nil {
+		libs.HandleServiceUnavailableError(c, "cannot send emails, mailer not configured")
+		return
+	}
+
+	token, err := h.UserService.UpdateEmail(c.Request.Context(), user, request.Email)
+	if errors.Is(err, service.ErrEmailVerificationPending) {
+		libs.HandleTooManyRequestsError(c, err.Error())
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	err = h.MailerService.SendMail(request.Email, "Verify your email address", "email_verification.txt",
+		gin.H{"Username": user.Username, "Token": token})
+	if err != nil {
+		if err := h.UserService.CancelEmailVerification(c.Request.Context(), user, token); err != nil {
+			panic(err)
+		}
+		libs.HandleServiceUnavailableError(c, "cannot send verification email")
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+	"github.com/portfolio-report/pr-api/service"
+)
+
+type verifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// VerifyEmail marks email address of current user as verified
+func (h *authHandler) VerifyEmail(c *gin.Context) {
+	user := middleware.UserFromContext(c.Request.Context())
+
+	var request verifyEmailRequest
+	if err := c.BindJSON(&request); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	err := h.UserService.VerifyEmail(c.Request.Context(), user, request.Token)
+	if errors.Is(err, service.ErrInvalidEmailVerificationToken) {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -2,12 +2,21 @@ package currencies
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/libs"
 )
 
 // GetCurrencies returns all currencies with their exchange rates
 func (h *currenciesHandler) GetCurrencies(c *gin.Context) {
+	lastModified, count := h.CurrenciesService.GetCurrenciesLastModified()
+	etag := libs.NewETag(lastModified.UTC().Format(time.RFC3339Nano), strconv.FormatInt(count, 10))
+	if libs.HandleConditionalRequest(c, etag, lastModified) {
+		return
+	}
+
 	currencies := h.CurrenciesService.GetCurrencies()
 	c.JSON(http.StatusOK, currencies)
 }
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetEventCalendar lists events across all securities,
+// with portfolioId as forecast of income of holdings in portfolio
+func (h *eventsHandler) GetEventCalendar(c *gin.Context) {
+	type Query struct {
+		From         string `form:"from" binding:"omitempty,DateYYYY-MM-DD"`
+		To           string `form:"to" binding:"omitempty,DateYYYY-MM-DD"`
+		Type         string `form:"type" binding:"omitempty,oneof=dividend split"`
+		Tag          string `form:"tag"`
+		SecurityType string `form:"securityType"`
+		PortfolioID  uint   `form:"portfolioId"`
+		Limit        int    `form:"limit" binding:"omitempty,min=1,max=1000"`
+		Skip         int    `form:"skip" binding:"omitempty,min=0"`
+	}
+
+	var q Query
+	if err := c.BindQuery(&q); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	query := &model.EventCalendarQuery{
+		Limit: q.Limit,
+		Skip:  q.Skip,
+	}
+	if q.From != "" {
+		from, _ := time.Parse("2006-01-02", q.From)
+		query.From = (*model.Date)(&from)
+	}
+	if q.To != "" {
+		to, _ := time.Parse("2006-01-02", q.To)
+		query.To = (*model.Date)(&to)
+	}
+	if q.Type != "" {
+		query.Type = &q.Type
+	}
+	if q.Tag != "" {
+		query.Tag = &q.Tag
+	}
+	if q.SecurityType != "" {
+		query.SecurityType = &q.SecurityType
+	}
+
+	var portfolio *model.Portfolio
+	if q.PortfolioID != 0 {
+		user := middleware.UserFromContext(c.Request.Context())
+		if user == nil {
+			libs.HandleUnauthorizedError(c)
+			return
+		}
+
+		var err error
+		portfolio, err = h.PortfolioService.GetPortfolioOfUserByID(user, q.PortfolioID)
+		if err != nil {
+			libs.HandleNotFoundError(c)
+			return
+		}
+	}
+
+	calendar := h.EventService.GetEventCalendar(query, portfolio)
+
+	c.JSON(http.StatusOK, gin.H{
+		"entries": calendar.Entries,
+		"params": gin.H{
+			"totalCount": calendar.TotalCount,
+			"limit":      query.Limit,
+			"skip":       query.Skip,
+		}})
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+)
+
+type eventsHandler struct {
+	model.EventService
+	model.PortfolioService
+}
+
+// NewHandler creates new events handler and registers routes
+func NewHandler(
+	R *gin.RouterGroup,
+	EventService model.EventService,
+	PortfolioService model.PortfolioService,
+) {
+	h := &eventsHandler{
+		EventService:     EventService,
+		PortfolioService: PortfolioService,
+	}
+
+	g := R.Group("/events")
+
+	// public, portfolio filter requires user:
+	g.GET("/calendar", h.GetEventCalendar)
+}
@@ -17,6 +17,7 @@ func (h *rootHandler) GraphqlHandler() gin.HandlerFunc {
 			PortfolioService:  h.PortfolioService,
 			CurrenciesService: h.CurrenciesService,
 			SecurityService:   h.SecurityService,
+			EventService:      h.EventService,
 		},
 	}))
 
@@ -2,14 +2,16 @@ package handler
 
 import (
 	"path"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/portfolio-report/pr-api/graph/dataloaders"
 	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/alerts"
 	"github.com/portfolio-report/pr-api/handler/auth"
 	"github.com/portfolio-report/pr-api/handler/currencies"
+	"github.com/portfolio-report/pr-api/handler/events"
+	"github.com/portfolio-report/pr-api/handler/markets"
 	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/handler/portfolios"
 	"github.com/portfolio-report/pr-api/handler/securities"
@@ -21,6 +23,10 @@ import (
 
 // Config holds configuration for all handlers
 type Config struct {
+	model.AlertService
+	model.EventService
+	model.MarketService
+	model.PriceService
 	model.UserService
 	model.SessionService
 	model.CurrenciesService
@@ -29,13 +35,16 @@ type Config struct {
 	model.TaxonomyService
 	model.MailerService
 	model.GeoipService
-	BaseURL     string
-	CacheMaxAge time.Duration
+	model.CacheService
+	BaseURL          string
+	SearchMaxResults int
+	LogoDir          string
 	*gorm.DB
 	*validator.Validate
 }
 
 type rootHandler struct {
+	model.EventService
 	model.UserService
 	model.SessionService
 	model.CurrenciesService
@@ -51,6 +60,7 @@ type rootHandler struct {
 // NewHandler creates new root handler and registers routes
 func NewHandler(R *gin.Engine, c *Config) {
 	h := &rootHandler{
+		EventService:      c.EventService,
 		UserService:       c.UserService,
 		SessionService:    c.SessionService,
 		CurrenciesService: c.CurrenciesService,
@@ -81,7 +91,7 @@ func NewHandler(R *gin.Engine, c *Config) {
 	h.RegisterSwaggerUi(g, "/doc")
 
 	// /auth
-	auth.NewHandler(g, c.Validate, c.SessionService, c.UserService)
+	auth.NewHandler(g, c.Validate, c.SessionService, c.UserService, c.MailerService)
 
 	// /currencies
 	currencies.NewHandler(g, c.UserService, c.SessionService, c.CurrenciesService)
@@ -93,7 +103,13 @@ func NewHandler(R *gin.Engine, c *Config) {
 	g.POST("/contact", h.Contact)
 
 	// /securities
-	securities.NewHandler(g, c.DB, c.Validate, c.CacheMaxAge, c.UserService, c.SecurityService, c.SessionService)
+	securities.NewHandler(g, c.DB, c.Validate, c.SearchMaxResults, c.UserService, c.SecurityService, c.SessionService, c.MarketService, c.PriceService, c.EventService, c.CacheService)
+
+	// /events
+	events.NewHandler(g, c.EventService, c.PortfolioService)
+
+	// /markets
+	markets.NewHandler(g, c.UserService, c.SessionService, c.MarketService)
 
 	// /portfolios
 	portfolios.NewHandler(g, c.SessionService, c.UserService, c.PortfolioService)
@@ -104,4 +120,12 @@ func NewHandler(R *gin.Engine, c *Config) {
 	// /taxonomies
 	taxonomies.NewHandler(g, c.Validate, c.UserService, c.SessionService, c.TaxonomyService)
 
+	// /alerts
+	alerts.NewHandler(g, c.SessionService, c.UserService, c.AlertService)
+
+	// /logos
+	if c.LogoDir != "" {
+		h.RegisterLogos(g, "/logos", c.LogoDir)
+	}
+
 }
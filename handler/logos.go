@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterLogos registers endpoint that serves logos stored in local directory.
+// File names of logos are random and never reused, so they can be cached forever.
+func (h *rootHandler) RegisterLogos(g *gin.RouterGroup, prefix string, dir string) {
+	logos := g.Group(prefix, func(c *gin.Context) {
+		c.Header("X-Content-Type-Options", "nosniff")
+		c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+		c.Writer = &logoResponseWriter{c.Writer}
+	})
+	logos.Static("/", dir)
+}
+
+// logoResponseWriter allows caching of served logos, but not of missing ones
+type logoResponseWriter struct {
+	gin.ResponseWriter
+}
+
+func (w *logoResponseWriter) WriteHeader(code int) {
+	if code == http.StatusOK || code == http.StatusNotModified {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,25 @@
+package markets
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// DeleteMarket removes market
+func (h *marketsHandler) DeleteMarket(c *gin.Context) {
+	market, err := h.MarketService.DeleteMarket(c.Param("code"))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, market)
+}
@@ -0,0 +1,19 @@
+package markets
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetMarket returns single market
+func (h *marketsHandler) GetMarket(c *gin.Context) {
+	market, err := h.MarketService.GetMarket(c.Param("code"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, market)
+}
@@ -0,0 +1,36 @@
+package markets
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetMarketCalendar returns holidays of market in year
+func (h *marketsHandler) GetMarketCalendar(c *gin.Context) {
+	type Query struct {
+		Year int `form:"year" binding:"omitempty,min=1900,max=2200"`
+	}
+
+	var q Query
+	if err := c.ShouldBindQuery(&q); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	if q.Year == 0 {
+		q.Year = time.Now().Year()
+	}
+
+	calendar, err := h.MarketService.GetMarketCalendar(c.Param("code"), q.Year)
+	if errors.Is(err, model.ErrNotFound) {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, calendar)
+}
@@ -0,0 +1,12 @@
+package markets
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetMarkets lists all markets
+func (h *marketsHandler) GetMarkets(c *gin.Context) {
+	c.JSON(http.StatusOK, h.MarketService.GetMarkets())
+}
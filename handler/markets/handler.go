@@ -0,0 +1,48 @@
+package markets
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+)
+
+type marketsHandler struct {
+	model.UserService
+	model.SessionService
+	model.MarketService
+}
+
+// NewHandler creates new markets handler and registers routes
+func NewHandler(
+	R *gin.RouterGroup,
+	UserService model.UserService,
+	SessionService model.SessionService,
+	MarketService model.MarketService,
+) {
+	h := &marketsHandler{
+		UserService:    UserService,
+		SessionService: SessionService,
+		MarketService:  MarketService,
+	}
+
+	g := R.Group("/markets")
+
+	// public:
+	g.GET("/", h.GetMarkets)
+	g.GET("/:code", h.GetMarket)
+	g.GET("/:code/calendar", h.GetMarketCalendar)
+
+	// admin:
+	g.POST("/",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PostMarket)
+	g.PUT("/:code",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PutMarket)
+	g.DELETE("/:code",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.DeleteMarket)
+}
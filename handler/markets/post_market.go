@@ -0,0 +1,26 @@
+package markets
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// PostMarket creates new market
+func (h *marketsHandler) PostMarket(c *gin.Context) {
+	var input model.MarketInput
+	if err := c.BindJSON(&input); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	market, err := h.MarketService.CreateMarket(&input)
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, market)
+}
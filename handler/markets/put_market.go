@@ -0,0 +1,31 @@
+package markets
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// PutMarket updates (and renames) market
+func (h *marketsHandler) PutMarket(c *gin.Context) {
+	var input model.MarketInput
+	if err := c.BindJSON(&input); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	market, err := h.MarketService.UpdateMarket(c.Param("code"), &input)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, market)
+}
@@ -0,0 +1,32 @@
+package portfolios
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// ClonePortfolio creates deep copy of portfolio, body with options is optional
+func (h *portfoliosHandler) ClonePortfolio(c *gin.Context) {
+	user := middleware.UserFromContext(c.Request.Context())
+	portfolio := middleware.PortfolioFromContext(c)
+
+	var input model.PortfolioCloneInput
+	if err := c.ShouldBindJSON(&input); err != nil && !errors.Is(err, io.EOF) {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	clone, err := h.PortfolioService.ClonePortfolio(user, uint(portfolio.ID), &input)
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, clone)
+}
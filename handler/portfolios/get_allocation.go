@@ -0,0 +1,35 @@
+package portfolios
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetAllocation allocates value of holdings in portfolio to taxonomies of root taxonomy,
+// with lookThrough funds are allocated by their constituents
+func (h *portfoliosHandler) GetAllocation(c *gin.Context) {
+	type Query struct {
+		RootTaxonomyUUID string `form:"rootTaxonomyUuid" binding:"required,uuid"`
+		LookThrough      bool   `form:"lookThrough"`
+	}
+
+	var q Query
+	if err := c.BindQuery(&q); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	portfolioId := middleware.PortfolioFromContext(c).ID
+	allocation, err := h.PortfolioService.GetPortfolioAllocation(
+		portfolioId, uuid.MustParse(q.RootTaxonomyUUID), q.LookThrough)
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, allocation)
+}
@@ -46,6 +46,10 @@ func NewHandler(
 		middleware.RequireUser(SessionService, UserService),
 		middleware.RequirePortfolioPerm(PortfolioService),
 		h.DeletePortfolio)
+	g.POST("/:portfolioId/clone",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequirePortfolioPerm(PortfolioService),
+		h.ClonePortfolio)
 
 	// securities
 	g.GET("/:portfolioId/securities/",
@@ -61,6 +65,11 @@ func NewHandler(
 		middleware.RequirePortfolioPerm(PortfolioService),
 		h.DeleteSecurity)
 
+	g.GET("/:portfolioId/allocation",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequirePortfolioPerm(PortfolioService),
+		h.GetAllocation)
+
 	// accounts
 	g.GET("/:portfolioId/accounts/",
 		middleware.RequireUser(SessionService, UserService),
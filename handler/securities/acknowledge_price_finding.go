@@ -0,0 +1,47 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// AcknowledgePriceFinding marks finding of price data quality checks as acknowledged
+func (h *securitiesHandler) AcknowledgePriceFinding(c *gin.Context) {
+	type Input struct {
+		Note *string `json:"note" binding:"omitempty,max=1000"`
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	var input Input
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&input); err != nil {
+			libs.HandleBadRequestError(c, err.Error())
+			return
+		}
+	}
+
+	user := middleware.UserFromContext(c.Request.Context())
+
+	finding, err := h.PriceService.AcknowledgePriceFinding(uint(id), user, input.Note)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, finding)
+}
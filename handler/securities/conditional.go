@@ -0,0 +1,138 @@
+package securities
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// securityMarketValidators are the attributes of a market which change with its representation
+type securityMarketValidators struct {
+	MarketCode    string
+	UpdatedAt     time.Time
+	LastPriceDate *model.Date
+}
+
+// CheckSecurityModified sets ETag and Last-Modified of public security and
+// returns Not Modified for current copies without building the response.
+// Requests of unknown securities are passed to the handler.
+func (h *securitiesHandler) CheckSecurityModified(c *gin.Context) {
+	if err := h.Validate.Var(c.Param("uuid"), "required,LaxUuid"); err != nil {
+		return
+	}
+	securityUuid := h.SecurityService.ResolveSecurityUUID(uuid.MustParse(c.Param("uuid")))
+
+	var security struct{ UpdatedAt time.Time }
+	result := h.DB.Table("securities").Select("updated_at").Where("uuid = ?", securityUuid).Limit(1).Find(&security)
+	if result.Error != nil {
+		panic(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+
+	var markets []securityMarketValidators
+	if err := h.DB.Table("securities_markets").
+		Select("market_code", "updated_at", "last_price_date").
+		Where("security_uuid = ?", securityUuid).
+		Order("market_code").
+		Find(&markets).Error; err != nil {
+		panic(err)
+	}
+
+	parts := []string{securityUuid.String(), security.UpdatedAt.UTC().Format(time.RFC3339Nano)}
+	lastModified := security.UpdatedAt
+	for _, m := range markets {
+		parts = append(parts, m.MarketCode, m.UpdatedAt.UTC().Format(time.RFC3339Nano), optionalDateString(m.LastPriceDate))
+		if m.UpdatedAt.After(lastModified) {
+			lastModified = m.UpdatedAt
+		}
+	}
+
+	libs.HandleConditionalRequest(c, libs.NewETag(parts...), lastModified)
+}
+
+// CheckSecurityPricesModified sets ETag and Last-Modified of prices of security
+// market and returns Not Modified for current copies without loading prices.
+func (h *securitiesHandler) CheckSecurityPricesModified(c *gin.Context) {
+	if err := h.Validate.Var(c.Param("uuid"), "LaxUuid"); err != nil {
+		return
+	}
+
+	etag, lastModified, found := h.securityPricesValidators(c.Param("uuid"), c.Param("marketCode"), c.Query("from"))
+	if !found {
+		return
+	}
+	libs.HandleConditionalRequest(c, etag, lastModified)
+}
+
+// securityPricesValidators returns ETag and Last-Modified of prices of security market
+// starting at from (empty for default). Events are included by the security, since
+// prices may be adjusted by them. found is false if security has no such market.
+// Merged securities are resolved.
+func (h *securitiesHandler) securityPricesValidators(
+	securityUuid string, marketCode string, from string,
+) (etag string, lastModified time.Time, found bool) {
+	securityUuid = h.resolveSecurityUuid(securityUuid)
+
+	var market struct {
+		securityMarketValidators
+		SecurityUpdatedAt time.Time
+	}
+	result := h.DB.Table("securities_markets m").
+		Select("m.market_code", "m.updated_at", "m.last_price_date", "s.updated_at AS security_updated_at").
+		Joins("JOIN securities s ON s.uuid = m.security_uuid").
+		Where("m.market_code = ? AND m.security_uuid = ?", marketCode, securityUuid).
+		Limit(1).
+		Find(&market)
+	if result.Error != nil {
+		panic(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return "", time.Time{}, false
+	}
+
+	lastModified = market.UpdatedAt
+	if market.SecurityUpdatedAt.After(lastModified) {
+		lastModified = market.SecurityUpdatedAt
+	}
+
+	// Without from, the range of prices moves every day
+	if from == "" {
+		from = defaultPricesFrom()
+		y, m, d := time.Now().Date()
+		today := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+		if today.After(lastModified) {
+			lastModified = today
+		}
+	}
+
+	etag = libs.NewETag(
+		securityUuid, market.MarketCode, from,
+		market.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		optionalDateString(market.LastPriceDate),
+		market.SecurityUpdatedAt.UTC().Format(time.RFC3339Nano),
+	)
+	return etag, lastModified, true
+}
+
+// resolveSecurityUuid returns UUID of security into which security was merged,
+// invalid UUIDs are returned as they are
+func (h *securitiesHandler) resolveSecurityUuid(securityUuid string) string {
+	parsed, err := uuid.Parse(securityUuid)
+	if err != nil {
+		return securityUuid
+	}
+	return h.SecurityService.ResolveSecurityUUID(parsed).String()
+}
+
+// optionalDateString returns date as string, empty string for nil
+func optionalDateString(date *model.Date) string {
+	if date == nil {
+		return ""
+	}
+	return date.String()
+}
@@ -0,0 +1,26 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// DeleteCache purges cached responses, only those of security if securityUuid is given
+func (h *securitiesHandler) DeleteCache(c *gin.Context) {
+	if param := c.Query("securityUuid"); param != "" {
+		securityUuid, err := uuid.Parse(param)
+		if err != nil {
+			libs.HandleBadRequestError(c, "invalid securityUuid")
+			return
+		}
+		h.CacheService.InvalidateTags(model.SecurityCacheTag(securityUuid))
+	} else {
+		h.CacheService.Purge()
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
 	"gorm.io/gorm"
 )
@@ -18,7 +19,7 @@ func (h *securitiesHandler) DeleteLogo(c *gin.Context) {
 		return
 	}
 
-	h.SecurityService.DeleteLogo(uuid)
+	err = h.SecurityService.DeleteLogo(uuid, middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			libs.HandleNotFoundError(c)
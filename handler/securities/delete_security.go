@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
 )
 
@@ -16,7 +17,7 @@ func (h *securitiesHandler) DeleteSecurity(c *gin.Context) {
 		return
 	}
 
-	security, err := h.SecurityService.DeleteSecurity(uuid)
+	security, err := h.SecurityService.DeleteSecurity(uuid, middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
 		libs.HandleNotFoundError(c)
 		return
@@ -0,0 +1,36 @@
+package securities
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// DeleteSecurityEvent removes event of security
+func (h *securitiesHandler) DeleteSecurityEvent(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	event, err := h.EventService.DeleteSecurityEvent(securityUuid, uint(id))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	h.PriceService.InvalidatePriceAdjustments(securityUuid.String())
+	h.CacheService.InvalidateTags(model.SecurityCacheTag(securityUuid))
+
+	c.JSON(http.StatusOK, event)
+}
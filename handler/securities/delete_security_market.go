@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
 )
 
@@ -17,11 +18,13 @@ func (h *securitiesHandler) DeleteSecurityMarket(c *gin.Context) {
 	}
 	marketCode := c.Param("marketCode")
 
-	market, err := h.SecurityService.DeleteSecurityMarket(uuid, marketCode)
+	market, err := h.SecurityService.DeleteSecurityMarket(uuid, marketCode, middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
 		libs.HandleNotFoundError(c)
 		return
 	}
 
+	h.PriceService.InvalidatePriceAdjustments(uuid.String())
+
 	c.JSON(http.StatusOK, market)
 }
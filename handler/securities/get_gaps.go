@@ -4,14 +4,21 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
 	"github.com/portfolio-report/pr-api/libs"
 )
 
 // GetGaps lists gaps in security prices
 func (h *securitiesHandler) GetGaps(c *gin.Context) {
 	type Query struct {
-		MinDuration int `form:"minDuration"`
-		MaxResults  int `form:"maxResults"`
+		MinDuration  int    `form:"minDuration" binding:"omitempty,min=1"`
+		MarketCode   string `form:"marketCode"`
+		SecurityType string `form:"securityType"`
+		Limit        int    `form:"limit" binding:"omitempty,min=1,max=1000"`
+		Skip         int    `form:"skip" binding:"omitempty,min=0"`
+		Cursor       string `form:"cursor"`
+		TotalCount   bool   `form:"totalCount"`
+		MaxResults   int    `form:"maxResults" binding:"omitempty,min=1,max=1000"` // deprecated, use limit
 	}
 
 	var q Query
@@ -24,11 +31,32 @@ func (h *securitiesHandler) GetGaps(c *gin.Context) {
 		q.MinDuration = 3
 	}
 
-	if q.MaxResults == 0 {
-		q.MaxResults = 10
+	if q.Limit == 0 {
+		q.Limit = q.MaxResults
+	}
+	if q.Limit == 0 {
+		q.Limit = 10
 	}
 
-	gaps := h.SecurityService.FindGapsInPrices(q.MinDuration, q.MaxResults)
+	gaps, err := h.SecurityService.FindGapsInPrices(&model.PriceGapsQuery{
+		MinDuration:  q.MinDuration,
+		MarketCode:   q.MarketCode,
+		SecurityType: q.SecurityType,
+		Limit:        q.Limit,
+		Skip:         q.Skip,
+		Cursor:       q.Cursor,
+		TotalCount:   q.TotalCount,
+	})
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
 
-	c.JSON(http.StatusOK, gaps)
+	c.JSON(http.StatusOK, gin.H{
+		"entries": gaps.Entries,
+		"params": gin.H{
+			"totalCount": gaps.TotalCount,
+			"nextCursor": gaps.NextCursor,
+		},
+	})
 }
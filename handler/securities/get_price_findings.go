@@ -0,0 +1,54 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetPriceFindings lists findings of price data quality checks
+func (h *securitiesHandler) GetPriceFindings(c *gin.Context) {
+	type Query struct {
+		Type         string `form:"type" binding:"omitempty,oneof=jump nonPositive stale plateau"`
+		MarketCode   string `form:"marketCode"`
+		SecurityUUID string `form:"securityUuid" binding:"omitempty,uuid"`
+		Acknowledged *bool  `form:"acknowledged"`
+		Limit        int    `form:"limit" binding:"omitempty,min=1,max=1000"`
+		Skip         int    `form:"skip" binding:"omitempty,min=0"`
+	}
+
+	var q Query
+	if err := c.ShouldBindQuery(&q); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	query := &model.PriceFindingsQuery{
+		Type:         model.PriceFindingType(q.Type),
+		MarketCode:   q.MarketCode,
+		Acknowledged: q.Acknowledged,
+		Limit:        q.Limit,
+		Skip:         q.Skip,
+	}
+	if query.Limit == 0 {
+		query.Limit = 100
+	}
+	if q.SecurityUUID != "" {
+		securityUuid := uuid.MustParse(q.SecurityUUID)
+		query.SecurityUUID = &securityUuid
+	}
+
+	findings := h.PriceService.GetPriceFindings(query)
+
+	c.JSON(http.StatusOK, gin.H{
+		"entries": findings.Entries,
+		"params": gin.H{
+			"totalCount": findings.TotalCount,
+			"limit":      query.Limit,
+			"skip":       query.Skip,
+		},
+	})
+}
@@ -0,0 +1,23 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetPriceUpdates lists results of last price updates of security markets
+func (h *securitiesHandler) GetPriceUpdates(c *gin.Context) {
+	type Query struct {
+		Failed bool `form:"failed"`
+	}
+
+	var q Query
+	if err := c.ShouldBindQuery(&q); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, h.PriceService.GetPriceUpdateStatus(q.Failed))
+}
@@ -2,6 +2,7 @@ package securities
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/portfolio-report/pr-api/db"
@@ -30,15 +31,6 @@ func (h *securitiesHandler) GetSecurities(c *gin.Context) {
 		q.Limit = 10
 	}
 
-	switch q.Sort {
-	case "symbolXfra":
-		q.Sort = "symbol_xfra"
-	case "symbolXnas":
-		q.Sort = "symbol_xnas"
-	case "symbolXnys":
-		q.Sort = "symbol_xnys"
-	}
-
 	query := h.DB
 
 	if q.Search != "" {
@@ -47,9 +39,14 @@ func (h *securitiesHandler) GetSecurities(c *gin.Context) {
 		} else {
 			like := "%" + q.Search + "%"
 			query = query.Where(
-				"name ILIKE ? OR isin ILIKE ? OR wkn ILIKE ? OR "+
-					"symbol_xfra ILIKE ? OR symbol_xnas ILIKE ? OR symbol_xnys ILIKE ?",
-				like, like, like, like, like, like)
+				"name ILIKE ? OR isin ILIKE ? OR wkn ILIKE ? OR EXISTS (?) OR EXISTS (?)",
+				like, like, like,
+				h.DB.Table("securities_identifiers si").
+					Select("1").
+					Where("si.security_uuid = securities.uuid AND si.value ILIKE ?", like),
+				h.DB.Table("securities_aliases sa").
+					Select("1").
+					Where("sa.security_uuid = securities.uuid AND sa.name ILIKE ?", like))
 		}
 	}
 
@@ -63,10 +60,14 @@ func (h *securitiesHandler) GetSecurities(c *gin.Context) {
 	}
 
 	order := "name"
-	for _, col := range []string{"uuid", "name", "isin", "wkn", "symbol_xfra", "symbol_xnas", "symbol_xnys"} {
-		if q.Sort == col {
-			order = col
-		}
+	switch q.Sort {
+	case "uuid", "isin", "wkn":
+		order = q.Sort
+	case "symbolXfra", "symbolXnas", "symbolXnys":
+		// Tickers of legacy symbol attributes are sorted by market
+		order = "(SELECT value FROM securities_identifiers si " +
+			"WHERE si.security_uuid = securities.uuid AND si.scheme = 'ticker' AND si.mic = '" +
+			strings.ToUpper(strings.TrimPrefix(q.Sort, "symbol")) + "')"
 	}
 	if q.Desc {
 		order += " desc"
@@ -76,7 +77,7 @@ func (h *securitiesHandler) GetSecurities(c *gin.Context) {
 	query = query.Limit(q.Limit).Offset(q.Skip)
 
 	var securities []db.Security
-	if err := query.Preload("Events").Preload("SecurityMarkets").Find(&securities).Error; err != nil {
+	if err := query.Preload("Events").Preload("SecurityMarkets").Scopes(db.PreloadIdentifiers, db.PreloadAliases).Find(&securities).Error; err != nil {
 		panic(err)
 	}
 
@@ -107,20 +108,23 @@ func (h *securitiesHandler) GetSecurities(c *gin.Context) {
 			}
 		}
 
-		logoUrl := h.SecurityService.LogoUrlFromExtras(s.Extras)
+		logo := h.SecurityService.LogoFromExtras(s.Extras)
 
 		entries = append(entries, gin.H{
 			"uuid":         s.UUID,
 			"name":         s.Name,
 			"isin":         s.Isin,
 			"wkn":          s.Wkn,
-			"symbolXfra":   s.SymbolXfra,
-			"symbolXnas":   s.SymbolXnas,
-			"symbolXnys":   s.SymbolXnys,
+			"symbolXfra":   s.Ticker("XFRA"),
+			"symbolXnas":   s.Ticker("XNAS"),
+			"symbolXnys":   s.Ticker("XNYS"),
+			"identifiers":  securityIdentifiersResponseFromDB(s.Identifiers),
+			"aliases":      securityAliasesResponseFromDB(s.Aliases),
 			"securityType": s.SecurityType,
 			"markets":      markets,
 			"events":       events,
-			"logoUrl":      logoUrl,
+			"logoUrl":      logo.LogoURL,
+			"logoThumbUrl": logo.LogoThumbURL,
 		})
 	}
 
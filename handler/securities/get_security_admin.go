@@ -20,7 +20,7 @@ func (h *securitiesHandler) GetSecurityAdmin(c *gin.Context) {
 	}
 
 	var s db.Security
-	err := h.DB.Preload("Events").Preload("SecurityMarkets").Preload("SecurityTaxonomies").Take(&s, "uuid = ?", uuid).Error
+	err := h.DB.Preload("Events").Preload("SecurityMarkets").Preload("SecurityTaxonomies").Scopes(db.PreloadIdentifiers, db.PreloadAliases).Take(&s, "uuid = ?", uuid).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			libs.HandleNotFoundError(c)
@@ -66,20 +66,23 @@ func (h *securitiesHandler) GetSecurityAdmin(c *gin.Context) {
 		})
 	}
 
-	logoUrl := h.SecurityService.LogoUrlFromExtras(s.Extras)
+	logo := h.SecurityService.LogoFromExtras(s.Extras)
 
 	c.JSON(http.StatusOK, gin.H{
 		"uuid":               s.UUID,
 		"name":               s.Name,
 		"isin":               s.Isin,
 		"wkn":                s.Wkn,
-		"symbolXfra":         s.SymbolXfra,
-		"symbolXnas":         s.SymbolXnas,
-		"symbolXnys":         s.SymbolXnys,
+		"symbolXfra":         s.Ticker("XFRA"),
+		"symbolXnas":         s.Ticker("XNAS"),
+		"symbolXnys":         s.Ticker("XNYS"),
+		"identifiers":        securityIdentifiersResponseFromDB(s.Identifiers),
+		"aliases":            securityAliasesResponseFromDB(s.Aliases),
 		"securityType":       s.SecurityType,
 		"markets":            markets,
 		"events":             events,
 		"securityTaxonomies": taxonomies,
-		"logoUrl":            logoUrl,
+		"logoUrl":            logo.LogoURL,
+		"logoThumbUrl":       logo.LogoThumbURL,
 	})
 }
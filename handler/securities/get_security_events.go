@@ -0,0 +1,26 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetSecurityEvents lists events of security incl. their IDs
+func (h *securitiesHandler) GetSecurityEvents(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	events, err := h.EventService.GetSecurityEvents(securityUuid)
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
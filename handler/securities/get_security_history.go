@@ -0,0 +1,36 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetSecurityHistory lists changes of master data of security, latest first
+func (h *securitiesHandler) GetSecurityHistory(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	changes, err := h.SecurityService.GetSecurityHistory(securityUuid)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		panic(err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"entries": changes,
+		"params": gin.H{
+			"totalCount": len(changes),
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// GetSecurityHoldings lists constituents of fund
+func (h *securitiesHandler) GetSecurityHoldings(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	// Securities merged into another security are redirected to it
+	securityUuid = h.SecurityService.ResolveSecurityUUID(securityUuid)
+
+	holdings, err := h.SecurityService.GetSecurityHoldings(securityUuid)
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, holdings)
+}
@@ -2,15 +2,23 @@ package securities
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
 	"github.com/portfolio-report/pr-api/libs"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
+// priceFields lists fields of prices selectable in response
+var priceFields = map[string]bool{"open": true, "high": true, "low": true, "close": true, "volume": true}
+
 // GetSecurityPrices returns the market and prices of security
 func (h *securitiesHandler) GetSecurityPrices(c *gin.Context) {
 	uuid := c.Param("uuid")
@@ -23,7 +31,7 @@ func (h *securitiesHandler) GetSecurityPrices(c *gin.Context) {
 
 	from := c.Query("from")
 	if from == "" {
-		from = time.Now().AddDate(0, 0, -14).Format("2006-01-02")
+		from = defaultPricesFrom()
 	}
 
 	if err := h.Validate.Var(from, "DateYYYY-MM-DD"); err != nil {
@@ -31,6 +39,49 @@ func (h *securitiesHandler) GetSecurityPrices(c *gin.Context) {
 		return
 	}
 
+	// Only close is returned by default to keep response backward compatible
+	fields := []string{"close"}
+	if f := c.Query("fields"); f != "" {
+		fields = strings.Split(f, ",")
+		for _, field := range fields {
+			if !priceFields[field] {
+				libs.HandleBadRequestError(c, "fields contains invalid field "+field)
+				return
+			}
+		}
+	}
+
+	adjustment := model.PriceAdjustment(c.Query("adjusted"))
+	if adjustment != "" && !adjustment.IsValid() {
+		libs.HandleBadRequestError(c, "adjusted must be splits or total")
+		return
+	}
+
+	response, err := h.securityPrices(uuid, marketCode, from, fields, adjustment, c.Query("carryForward") == "true")
+	if errors.Is(err, model.ErrNotFound) {
+		libs.HandleNotFoundError(c)
+		return
+	}
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// securityPrices returns the market and prices of security as in response,
+// model.ErrNotFound if security has no such market. Merged securities are resolved.
+func (h *securitiesHandler) securityPrices(
+	uuid string,
+	marketCode string,
+	from string,
+	fields []string,
+	adjustment model.PriceAdjustment,
+	carryForward bool,
+) (gin.H, error) {
+	uuid = h.resolveSecurityUuid(uuid)
+
 	var market db.SecurityMarket
 	var prices []db.SecurityMarketPrice
 
@@ -38,15 +89,14 @@ func (h *securitiesHandler) GetSecurityPrices(c *gin.Context) {
 		Where("market_code = ? AND security_uuid = ?", marketCode, uuid).
 		Take(&market).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		libs.HandleNotFoundError(c)
-		return
+		return nil, model.ErrNotFound
 	}
 	if err != nil {
 		panic(err)
 	}
 
 	err = h.DB.
-		Select("date", "close").Where("security_market_id = ? AND date >= ?", market.ID, from).
+		Where("security_market_id = ? AND date >= ?", market.ID, from).
 		Order("date").
 		Find(&prices).Error
 
@@ -54,17 +104,131 @@ func (h *securitiesHandler) GetSecurityPrices(c *gin.Context) {
 		panic(err)
 	}
 
+	if carryForward {
+		prices = h.carryForwardPrices(market, from, prices)
+	}
+
+	if adjustment != "" {
+		factors, err := h.PriceService.GetPriceAdjustmentFactors(market.SecurityUUID, market.MarketCode, adjustment)
+		if err != nil {
+			return nil, fmt.Errorf("cannot adjust prices: %w", err)
+		}
+		prices = adjustPrices(prices, factors)
+	}
+
 	pricesResponse := []gin.H{}
 	for _, p := range prices {
-		pricesResponse = append(pricesResponse, gin.H{"date": p.Date, "close": p.Close})
+		price := gin.H{"date": p.Date}
+		for _, field := range fields {
+			switch field {
+			case "open":
+				price["open"] = p.Open
+			case "high":
+				price["high"] = p.High
+			case "low":
+				price["low"] = p.Low
+			case "close":
+				price["close"] = p.Close
+			case "volume":
+				price["volume"] = p.Volume
+			}
+		}
+		pricesResponse = append(pricesResponse, price)
 	}
 
-	c.JSON(http.StatusOK, gin.H{
+	return gin.H{
 		"marketCode":     market.MarketCode,
 		"currencyCode":   market.CurrencyCode,
 		"symbol":         market.Symbol,
 		"firstPriceDate": market.FirstPriceDate,
 		"lastPriceDate":  market.LastPriceDate,
-		"prices":         pricesResponse})
+		"prices":         pricesResponse}, nil
+}
+
+// defaultPricesFrom returns start of prices returned without from, two weeks ago
+func defaultPricesFrom() string {
+	return time.Now().AddDate(0, 0, -14).Format("2006-01-02")
+}
+
+// carryForwardPrices fills missing trading days of market with the last known
+// close, so that each trading day up to the last price date has a value
+func (h *securitiesHandler) carryForwardPrices(
+	market db.SecurityMarket,
+	from string,
+	prices []db.SecurityMarketPrice,
+) []db.SecurityMarketPrice {
+	if market.LastPriceDate == nil {
+		return prices
+	}
+
+	// Last price before range is carried into range
+	var previous []db.SecurityMarketPrice
+	if err := h.DB.
+		Where("security_market_id = ? AND date < ?", market.ID, from).
+		Order("date DESC").Limit(1).
+		Find(&previous).Error; err != nil {
+		panic(err)
+	}
+
+	fromDate, _ := time.Parse("2006-01-02", from)
+	tradingDays := h.MarketService.GetTradingDays(market.MarketCode, fromDate, market.LastPriceDate.Time())
+
+	filled := make([]db.SecurityMarketPrice, 0, len(tradingDays))
+	i := 0
+	for _, day := range tradingDays {
+		// Keep prices on days without trading as they are
+		for i < len(prices) && prices[i].Date.Time().Before(day) {
+			filled = append(filled, prices[i])
+			previous = []db.SecurityMarketPrice{prices[i]}
+			i++
+		}
 
+		if i < len(prices) && prices[i].Date.Time().Equal(day) {
+			filled = append(filled, prices[i])
+			previous = []db.SecurityMarketPrice{prices[i]}
+			i++
+		} else if len(previous) > 0 {
+			filled = append(filled, db.SecurityMarketPrice{Date: model.Date(day), Close: previous[0].Close})
+		}
+	}
+	return append(filled, prices[i:]...)
+}
+
+// adjustPrices multiplies prices with factor of first event after date of price,
+// volumes are adjusted for splits only
+func adjustPrices(prices []db.SecurityMarketPrice, factors []*model.PriceAdjustmentFactor) []db.SecurityMarketPrice {
+	if len(factors) == 0 {
+		return prices
+	}
+
+	adjust := func(d *db.DecimalString, factor decimal.Decimal) *db.DecimalString {
+		if d == nil {
+			return nil
+		}
+		adjusted := db.DecimalString(d.NullDecimal().Decimal.Mul(factor).Round(4).String())
+		return &adjusted
+	}
+
+	adjusted := make([]db.SecurityMarketPrice, len(prices))
+	for i, p := range prices {
+		adjusted[i] = p
+
+		idx := sort.Search(len(factors), func(j int) bool {
+			return factors[j].Date.Time().After(p.Date.Time())
+		})
+		if idx == len(factors) {
+			continue
+		}
+		f := factors[idx]
+
+		adjusted[i].Close = *adjust(&p.Close, f.Factor)
+		adjusted[i].Open = adjust(p.Open, f.Factor)
+		adjusted[i].High = adjust(p.High, f.Factor)
+		adjusted[i].Low = adjust(p.Low, f.Factor)
+		if p.Volume != nil {
+			volume := decimal.NewFromInt(*p.Volume).Mul(f.VolumeFactor).Round(0).IntPart()
+			adjusted[i].Volume = &volume
+		}
+	}
+	return adjusted
 }
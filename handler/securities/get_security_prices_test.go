@@ -0,0 +1,53 @@
+package securities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjustPrices(t *testing.T) {
+	a := assert.New(t)
+
+	date := func(s string) model.Date {
+		d, _ := time.Parse("2006-01-02", s)
+		return model.Date(d)
+	}
+	volume := func(v int64) *int64 { return &v }
+	open := db.DecimalString("20")
+
+	prices := []db.SecurityMarketPrice{
+		{Date: date("2022-01-03"), Close: "20", Open: &open, Volume: volume(100)},
+		{Date: date("2022-01-04"), Close: "30", Volume: volume(100)},
+		{Date: date("2022-01-05"), Close: "10", Volume: volume(200)},
+		{Date: date("2022-01-06"), Close: "9.9"},
+	}
+
+	// Dividend on 2022-01-06 and split 2:1 on 2022-01-05, factors accumulate backwards
+	factors := []*model.PriceAdjustmentFactor{
+		{Date: date("2022-01-05"), Factor: decimal.RequireFromString("0.495"), VolumeFactor: decimal.NewFromInt(2)},
+		{Date: date("2022-01-06"), Factor: decimal.RequireFromString("0.99"), VolumeFactor: decimal.NewFromInt(1)},
+	}
+
+	adjusted := adjustPrices(prices, factors)
+
+	a.Len(adjusted, 4)
+	a.Equal(db.DecimalString("9.9"), adjusted[0].Close)
+	a.Equal(db.DecimalString("9.9"), *adjusted[0].Open)
+	a.Equal(int64(200), *adjusted[0].Volume)
+	a.Equal(db.DecimalString("14.85"), adjusted[1].Close)
+	a.Equal(db.DecimalString("9.9"), adjusted[2].Close)
+	a.Equal(int64(200), *adjusted[2].Volume)
+	a.Equal(db.DecimalString("9.9"), adjusted[3].Close)
+	a.Nil(adjusted[3].Volume)
+
+	// Prices are not modified
+	a.Equal(db.DecimalString("20"), prices[0].Close)
+	a.Equal(db.DecimalString("20"), *prices[0].Open)
+
+	a.Equal(prices, adjustPrices(prices, nil))
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/db"
 	"github.com/portfolio-report/pr-api/graph/model"
 	"github.com/portfolio-report/pr-api/libs"
@@ -14,15 +15,16 @@ import (
 
 // GetSecurityPublic returns security with its public attributes
 func (h *securitiesHandler) GetSecurityPublic(c *gin.Context) {
-	uuid := c.Param("uuid")
-
-	if err := h.Validate.Var(uuid, "required,LaxUuid"); err != nil {
+	if err := h.Validate.Var(c.Param("uuid"), "required,LaxUuid"); err != nil {
 		libs.HandleNotFoundError(c)
 		return
 	}
 
+	// Securities merged into another security are redirected to it
+	securityUuid := h.SecurityService.ResolveSecurityUUID(uuid.MustParse(c.Param("uuid")))
+
 	var security db.Security
-	err := h.DB.Take(&security, "uuid = ?", uuid).Error
+	err := h.DB.Scopes(db.PreloadIdentifiers, db.PreloadAliases).Take(&security, "uuid = ?", securityUuid).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		libs.HandleNotFoundError(c)
 		return
@@ -34,7 +36,7 @@ func (h *securitiesHandler) GetSecurityPublic(c *gin.Context) {
 	var markets []db.SecurityMarket
 	err = h.DB.
 		Select("market_code", "symbol", "currency_code", "first_price_date", "last_price_date").
-		Where("security_uuid = ?", uuid).
+		Where("security_uuid = ?", securityUuid).
 		Find(&markets).Error
 	if err != nil {
 		panic(err)
@@ -42,7 +44,7 @@ func (h *securitiesHandler) GetSecurityPublic(c *gin.Context) {
 
 	var events []db.Event
 	err = h.DB.
-		Where("security_uuid = ? AND type IN ('dividend', 'split')", uuid).
+		Where("security_uuid = ? AND type IN ('dividend', 'split')", securityUuid).
 		Find(&events).Error
 	if err != nil {
 		panic(err)
@@ -51,14 +53,14 @@ func (h *securitiesHandler) GetSecurityPublic(c *gin.Context) {
 	var securityTaxonomies []db.SecurityTaxonomy
 	err = h.DB.
 		Preload("Taxonomy").
-		Where("security_uuid = ?", uuid).
+		Where("security_uuid = ?", securityUuid).
 		Find(&securityTaxonomies).Error
 	if err != nil {
 		panic(err)
 	}
 
 	var securityTags []db.SecurityTag
-	if err = h.DB.Preload("Tag").Find(&securityTags, "security_uuid = ?", uuid).Error; err != nil {
+	if err = h.DB.Preload("Tag").Find(&securityTags, "security_uuid = ?", securityUuid).Error; err != nil {
 		panic(err)
 	}
 	tags := make([]string, len(securityTags))
@@ -97,21 +99,24 @@ func (h *securitiesHandler) GetSecurityPublic(c *gin.Context) {
 		})
 	}
 
-	logoUrl := h.SecurityService.LogoUrlFromExtras(security.Extras)
+	logo := h.SecurityService.LogoFromExtras(security.Extras)
 
 	c.JSON(http.StatusOK, gin.H{
 		"uuid":               strings.Replace(security.UUID.String(), "-", "", 4),
 		"name":               security.Name,
 		"isin":               security.Isin,
 		"wkn":                security.Wkn,
-		"symbolXfra":         security.SymbolXfra,
-		"symbolXnas":         security.SymbolXnas,
-		"symbolXnys":         security.SymbolXnys,
+		"symbolXfra":         security.Ticker("XFRA"),
+		"symbolXnas":         security.Ticker("XNAS"),
+		"symbolXnys":         security.Ticker("XNYS"),
+		"identifiers":        securityIdentifiersResponseFromDB(security.Identifiers),
+		"aliases":            securityAliasesResponseFromDB(security.Aliases),
 		"securityType":       security.SecurityType,
 		"markets":            marketsResp,
 		"events":             eventsResp,
 		"securityTaxonomies": taxonomiesResp,
 		"tags":               tags,
-		"logoUrl":            logoUrl,
+		"logoUrl":            logo.LogoURL,
+		"logoThumbUrl":       logo.LogoThumbURL,
 	})
 }
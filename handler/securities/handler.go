@@ -1,12 +1,9 @@
 package securities
 
 import (
-	"time"
-
-	cache "github.com/chenyahui/gin-cache"
-	"github.com/chenyahui/gin-cache/persist"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/graph/model"
 	"github.com/portfolio-report/pr-api/handler/middleware"
 	"gorm.io/gorm"
@@ -18,6 +15,11 @@ type securitiesHandler struct {
 	model.UserService
 	*validator.Validate
 	model.SecurityService
+	model.MarketService
+	model.PriceService
+	model.EventService
+	model.CacheService
+	searchMaxResults int
 }
 
 // NewHandler creates new securities handler and registers routes
@@ -25,10 +27,14 @@ func NewHandler(
 	R *gin.RouterGroup,
 	DB *gorm.DB,
 	Validate *validator.Validate,
-	cacheMaxAge time.Duration,
+	searchMaxResults int,
 	UserService model.UserService,
 	SecurityService model.SecurityService,
 	SessionService model.SessionService,
+	MarketService model.MarketService,
+	PriceService model.PriceService,
+	EventService model.EventService,
+	CacheService model.CacheService,
 ) {
 	h := &securitiesHandler{
 		DB:              DB,
@@ -36,23 +42,25 @@ func NewHandler(
 		SecurityService: SecurityService,
 		UserService:     UserService,
 		Validate:        Validate,
+		MarketService:   MarketService,
+		PriceService:    PriceService,
+		EventService:    EventService,
+		CacheService:    CacheService,
+
+		searchMaxResults: searchMaxResults,
 	}
 
 	g := R.Group("/securities")
 
-	memoryStore := persist.NewMemoryStore(cacheMaxAge)
-
-	var cacheMiddleware gin.HandlerFunc
-	if cacheMaxAge != 0 {
-		cacheMiddleware = cache.CacheByRequestURI(memoryStore, cacheMaxAge)
-	} else {
-		cacheMiddleware = func(c *gin.Context) {}
-	}
+	cacheMiddleware := CacheService.Middleware(securityCacheTags)
 
 	// public:
+	g.GET("/search", h.SearchSecuritiesFaceted)
 	g.GET("/search/:searchTerm", h.SearchSecurities)
-	g.GET("/uuid/:uuid", cacheMiddleware, h.GetSecurityPublic)
-	g.GET("/uuid/:uuid/markets/:marketCode", cacheMiddleware, h.GetSecurityPrices)
+	g.GET("/uuid/:uuid", h.CheckSecurityModified, cacheMiddleware, h.GetSecurityPublic)
+	g.GET("/uuid/:uuid/markets/:marketCode", h.CheckSecurityPricesModified, cacheMiddleware, h.GetSecurityPrices)
+	g.GET("/uuid/:uuid/holdings", h.GetSecurityHoldings)
+	g.POST("/prices/batch", h.PostPricesBatch)
 
 	// admin:
 	g.GET("/",
@@ -91,13 +99,100 @@ func NewHandler(
 		middleware.RequireUser(SessionService, UserService),
 		middleware.RequireAdmin(),
 		h.DeleteSecurityMarket)
+	g.GET("/uuid/:uuid/events",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.GetSecurityEvents)
+	g.POST("/uuid/:uuid/events",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PostSecurityEvent)
+	g.PATCH("/uuid/:uuid/events",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PatchSecurityEvents)
+	g.PUT("/uuid/:uuid/events/:id",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PutSecurityEvent)
+	g.DELETE("/uuid/:uuid/events/:id",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.DeleteSecurityEvent)
+	g.GET("/uuid/:uuid/history",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.GetSecurityHistory)
+	g.POST("/uuid/:uuid/history/:version/revert",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.RevertSecurity)
+	g.POST("/uuid/:uuid/merge",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.MergeSecurity)
 	g.PUT("/uuid/:uuid/taxonomies/:rootUuid",
 		middleware.RequireUser(SessionService, UserService),
 		middleware.RequireAdmin(),
 		h.PutSecurityTaxonomies)
+	g.PUT("/uuid/:uuid/holdings",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PutSecurityHoldings)
+	g.POST("/prices/bulk",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PostPricesBulk)
 	g.GET("/maintenance/gaps",
 		middleware.RequireUser(SessionService, UserService),
 		middleware.RequireAdmin(),
 		h.GetGaps)
+	g.GET("/maintenance/price-updates",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.GetPriceUpdates)
+	g.POST("/maintenance/price-updates",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PostPriceUpdates)
+	g.GET("/maintenance/price-findings",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.GetPriceFindings)
+	g.POST("/maintenance/price-findings",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.PostPriceFindings)
+	g.POST("/maintenance/price-findings/:id/acknowledgement",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.AcknowledgePriceFinding)
+	g.DELETE("/maintenance/price-findings/:id/acknowledgement",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.UnacknowledgePriceFinding)
+	g.DELETE("/maintenance/cache",
+		middleware.RequireUser(SessionService, UserService),
+		middleware.RequireAdmin(),
+		h.DeleteCache)
 
 }
+
+// securityCacheTags returns tags of cached responses of security in path.
+// The ETag is included, so that cached responses always match their validators.
+func securityCacheTags(c *gin.Context) []string {
+	return securityCacheTagsOf(c.Param("uuid"), c.Writer.Header().Get("ETag"))
+}
+
+// securityCacheTagsOf returns tags of cached response of security with ETag (if any)
+func securityCacheTagsOf(securityUuid string, etag string) []string {
+	parsed, err := uuid.Parse(securityUuid)
+	if err != nil {
+		return []string{}
+	}
+	tags := []string{model.SecurityCacheTag(parsed)}
+	if etag != "" {
+		tags = append(tags, "etag:"+etag)
+	}
+	return tags
+}
@@ -0,0 +1,52 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// MergeSecurity merges duplicate security into security,
+// UUID of duplicate is redirected afterwards
+func (h *securitiesHandler) MergeSecurity(c *gin.Context) {
+	type Input struct {
+		DuplicateUUID uuid.UUID `json:"duplicateUuid" binding:"required"`
+		Prefer        string    `json:"prefer" binding:"omitempty,oneof=canonical duplicate"`
+	}
+
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	var input Input
+	if err := c.BindJSON(&input); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+	if input.Prefer == "" {
+		input.Prefer = string(model.SecurityMergePreferenceCanonical)
+	}
+
+	security, err := h.SecurityService.MergeSecurities(securityUuid, input.DuplicateUUID,
+		model.SecurityMergePreference(input.Prefer), middleware.UserFromContext(c.Request.Context()))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	h.PriceService.InvalidatePriceAdjustments(securityUuid.String())
+	h.PriceService.InvalidatePriceAdjustments(input.DuplicateUUID.String())
+
+	c.JSON(http.StatusOK, security)
+}
@@ -3,10 +3,12 @@ package securities
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
 	"gorm.io/gorm"
 )
@@ -37,29 +39,44 @@ func (h *securitiesHandler) PatchSecurity(c *gin.Context) {
 	if request.Name == nil {
 		request.Name = security.Name
 	}
+	// WKN and CUSIP of previous ISIN are not kept with new ISIN, but derived from it
+	isinChanged := request.Isin != nil &&
+		!strings.EqualFold(strings.TrimSpace(*request.Isin), strings.TrimSpace(stringOrEmpty(security.Isin)))
 	if request.Isin == nil {
 		request.Isin = security.Isin
 	}
-	if request.Wkn == nil {
+	if request.Wkn == nil && !isinChanged {
 		request.Wkn = security.Wkn
 	}
 	if request.SecurityType == nil {
 		request.SecurityType = security.SecurityType
 	}
-	if request.SymbolXfra == nil {
-		request.SymbolXfra = security.SymbolXfra
-	}
-	if request.SymbolXnas == nil {
-		request.SymbolXnas = security.SymbolXnas
+	if request.Identifiers == nil {
+		request.Identifiers = []*model.SecurityIdentifier{}
+		for _, i := range security.Identifiers {
+			if isinChanged && i.Scheme == string(model.SecurityIdentifierSchemeCusip) {
+				continue
+			}
+			request.Identifiers = append(request.Identifiers, i)
+		}
 	}
-	if request.SymbolXnys == nil {
-		request.SymbolXnys = security.SymbolXnys
+	if request.Aliases == nil {
+		request.Aliases = security.Aliases
 	}
 
-	security, err = h.SecurityService.UpdateSecurity(uuid, &request)
+	security, err = h.SecurityService.UpdateSecurity(uuid, &request, middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
-		panic(err)
+		libs.HandleBadRequestError(c, err.Error())
+		return
 	}
 
 	c.JSON(http.StatusOK, security)
 }
+
+// stringOrEmpty returns value of optional string, empty string for nil
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,46 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// PatchSecurityEvents creates or updates events of security identified by date and type
+func (h *securitiesHandler) PatchSecurityEvents(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	// Bound by value, so that null elements fail validation as empty events
+	var inputs []model.SecurityEventInput
+	if err := c.BindJSON(&inputs); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+	inputPtrs := make([]*model.SecurityEventInput, len(inputs))
+	for i := range inputs {
+		inputPtrs[i] = &inputs[i]
+	}
+
+	events, err := h.EventService.UpsertSecurityEvents(securityUuid, inputPtrs)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	h.PriceService.InvalidatePriceAdjustments(securityUuid.String())
+	h.CacheService.InvalidateTags(model.SecurityCacheTag(securityUuid))
+
+	c.JSON(http.StatusOK, events)
+}
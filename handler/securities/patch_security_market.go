@@ -2,14 +2,13 @@ package securities
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/lib/pq"
-	"github.com/portfolio-report/pr-api/db"
+	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
-	"gorm.io/gorm/clause"
+	"github.com/shopspring/decimal"
 )
 
 type patchSecurityMarketRequest struct {
@@ -17,15 +16,19 @@ type patchSecurityMarketRequest struct {
 	Symbol       *string `json:"symbol"`
 	UpdatePrices *bool   `json:"updatePrices"`
 	Prices       *[]struct {
-		Date  model.Date `json:"date"`
-		Close float64    `json:"close"`
-	} `json:"prices"`
+		Date   model.Date       `json:"date"`
+		Close  decimal.Decimal  `json:"close"`
+		Open   *decimal.Decimal `json:"open"`
+		High   *decimal.Decimal `json:"high"`
+		Low    *decimal.Decimal `json:"low"`
+		Volume *int64           `json:"volume" binding:"omitempty,min=0"`
+	} `json:"prices" binding:"omitempty,dive"`
 }
 
 // PatchSecurityMarket creates or updates market of security and its prices
 func (h *securitiesHandler) PatchSecurityMarket(c *gin.Context) {
-	uuid := c.Param("uuid")
-	if err := h.Validate.Var(uuid, "uuid"); err != nil {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
 		libs.HandleNotFoundError(c)
 		return
 	}
@@ -37,58 +40,40 @@ func (h *securitiesHandler) PatchSecurityMarket(c *gin.Context) {
 		return
 	}
 
-	var market db.SecurityMarket
-	err := h.DB.
-		Attrs(db.SecurityMarket{UpdatePrices: true}).
-		FirstOrInit(&market, db.SecurityMarket{SecurityUUID: uuid, MarketCode: marketCode}).
-		Error
-	if err != nil {
-		panic(err)
-	}
-
-	if req.CurrencyCode != nil {
-		market.CurrencyCode = *req.CurrencyCode
-	}
-	if req.Symbol != nil {
-		market.Symbol = req.Symbol
-	}
-	if req.UpdatePrices != nil {
-		market.UpdatePrices = *req.UpdatePrices
+	if _, err := h.MarketService.GetMarket(marketCode); err != nil {
+		libs.HandleBadRequestError(c, "unknown market "+marketCode)
+		return
 	}
 
-	if err := h.DB.Save(&market).Error; err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
-			libs.HandleBadRequestError(c, "data violates constraint "+pqErr.Constraint)
-			return
-		}
-
-		panic(err)
+	_, err = h.SecurityService.UpdateSecurityMarket(securityUuid, marketCode, &model.SecurityMarketInput{
+		CurrencyCode: req.CurrencyCode,
+		Symbol:       req.Symbol,
+		UpdatePrices: req.UpdatePrices,
+	}, middleware.UserFromContext(c.Request.Context()))
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
 	}
 
-	// Create/update the associated prices
 	if req.Prices != nil {
-		prices := []db.SecurityMarketPrice{}
+		prices := []*model.SecurityPrice{}
 		for _, p := range *req.Prices {
-			prices = append(prices, db.SecurityMarketPrice{
-				SecurityMarketID: market.ID,
-				Date:             p.Date,
-				Close:            db.DecimalString(strconv.FormatFloat(p.Close, 'f', 8, 64)),
+			prices = append(prices, &model.SecurityPrice{
+				Date:   p.Date,
+				Close:  p.Close,
+				Open:   p.Open,
+				High:   p.High,
+				Low:    p.Low,
+				Volume: p.Volume,
 			})
 		}
-
-		if err := h.DB.Clauses(clause.OnConflict{UpdateAll: true}).Create(&prices).Error; err != nil {
-			panic(err)
+		if err := h.PriceService.UpdateSecurityMarketPrices(securityUuid, marketCode, prices); err != nil {
+			libs.HandleBadRequestError(c, err.Error())
+			return
 		}
 	}
 
-	// Keep firstPriceDate and lastPriceDate up-to-date
-	err = h.DB.Exec(`UPDATE securities_markets SET `+
-		`first_price_date = (SELECT MIN(date) FROM securities_markets_prices WHERE security_market_id = ?), `+
-		`last_price_date =  (SELECT MAX(date) FROM securities_markets_prices WHERE security_market_id = ?) `+
-		`WHERE id = ?`, market.ID, market.ID, market.ID).Error
-	if err != nil {
-		panic(err)
-	}
+	h.CacheService.InvalidateTags(model.SecurityCacheTag(securityUuid))
 
 	c.JSON(http.StatusOK, gin.H{"status": "ok"})
 }
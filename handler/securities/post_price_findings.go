@@ -0,0 +1,47 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/libs"
+	"github.com/portfolio-report/pr-api/service"
+	"github.com/shopspring/decimal"
+)
+
+// PostPriceFindings checks quality of prices of all security markets immediately,
+// thresholds in body are optional
+func (h *securitiesHandler) PostPriceFindings(c *gin.Context) {
+	type Input struct {
+		JumpThreshold *decimal.Decimal `json:"jumpThreshold"`
+		StaleDays     *int             `json:"staleDays"`
+		PlateauDays   *int             `json:"plateauDays"`
+	}
+
+	var input Input
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&input); err != nil {
+			libs.HandleBadRequestError(c, err.Error())
+			return
+		}
+	}
+
+	options := service.DefaultPriceCheckOptions
+	if input.JumpThreshold != nil {
+		options.JumpThreshold = *input.JumpThreshold
+	}
+	if input.StaleDays != nil {
+		options.StaleDays = *input.StaleDays
+	}
+	if input.PlateauDays != nil {
+		options.PlateauDays = *input.PlateauDays
+	}
+
+	result, err := h.PriceService.CheckPriceQuality(&options)
+	if err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
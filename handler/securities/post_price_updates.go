@@ -0,0 +1,24 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/libs"
+	"github.com/portfolio-report/pr-api/service"
+)
+
+// PostPriceUpdates starts update of prices of all security markets in background,
+// results are listed by GetPriceUpdates
+func (h *securitiesHandler) PostPriceUpdates(c *gin.Context) {
+	if err := h.PriceService.StartPriceUpdate(); err != nil {
+		if errors.Is(err, service.ErrPriceUpdateRunning) {
+			libs.HandleConflictError(c, err.Error())
+			return
+		}
+		panic(err)
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"status": "started"})
+}
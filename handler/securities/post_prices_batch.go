@@ -0,0 +1,107 @@
+package securities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// pricesBatchSeries is one series in response of PostPricesBatch
+type pricesBatchSeries struct {
+	UUID       string          `json:"uuid"`
+	MarketCode string          `json:"marketCode"`
+	From       string          `json:"from,omitempty"`
+	Status     int             `json:"status"`
+	Series     json.RawMessage `json:"series"`
+}
+
+// PostPricesBatch returns prices of many security markets in one response.
+// Series are streamed one by one and cached as responses of GetSecurityPrices,
+// failures of single series are reported by their status.
+func (h *securitiesHandler) PostPricesBatch(c *gin.Context) {
+	var request struct {
+		Series []struct {
+			UUID       string `json:"uuid" binding:"required,LaxUuid"`
+			MarketCode string `json:"marketCode" binding:"required"`
+			From       string `json:"from" binding:"omitempty,DateYYYY-MM-DD"`
+		} `json:"series" binding:"required,max=1000,dive"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+	// Errors cannot be reported by status of response once streaming has started
+	for i, s := range request.Series {
+		if s.From == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", s.From); err != nil {
+			libs.HandleBadRequestError(c, fmt.Sprintf("series %d: from is not a valid date", i))
+			return
+		}
+	}
+
+	// Responses of GetSecurityPrices are cached by their request URI
+	pricesPath := strings.TrimSuffix(c.Request.URL.Path, "/prices/batch") + "/uuid/"
+
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Status(http.StatusOK)
+	c.Writer.WriteString("[")
+
+	encoder := json.NewEncoder(c.Writer)
+	for i, s := range request.Series {
+		if i > 0 {
+			c.Writer.WriteString(",")
+		}
+
+		entry := pricesBatchSeries{UUID: s.UUID, MarketCode: s.MarketCode, From: s.From, Status: http.StatusOK}
+
+		etag, _, found := h.securityPricesValidators(s.UUID, s.MarketCode, s.From)
+		if found {
+			uri := pricesPath + s.UUID + "/markets/" + url.PathEscape(s.MarketCode)
+			if s.From != "" {
+				uri += "?from=" + s.From
+			}
+
+			data, err := h.CacheService.Fetch(uri, securityCacheTagsOf(s.UUID, etag), func() ([]byte, error) {
+				from := s.From
+				if from == "" {
+					from = defaultPricesFrom()
+				}
+				response, err := h.securityPrices(s.UUID, s.MarketCode, from, []string{"close"}, "", false)
+				if err != nil {
+					return nil, err
+				}
+				return json.Marshal(response)
+			})
+			if errors.Is(err, model.ErrNotFound) {
+				found = false
+			} else if err != nil {
+				log.Println("Error while loading prices of batch:", err)
+				entry.Status = http.StatusInternalServerError
+			} else {
+				entry.Series = data
+			}
+		}
+		if !found {
+			entry.Status = http.StatusNotFound
+		}
+
+		if err := encoder.Encode(entry); err != nil {
+			// Client is gone
+			return
+		}
+		c.Writer.Flush()
+	}
+
+	c.Writer.WriteString("]")
+}
@@ -0,0 +1,42 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// PostPricesBulk imports prices of many security markets from CSV or NDJSON body
+func (h *securitiesHandler) PostPricesBulk(c *gin.Context) {
+	var format model.PriceImportFormat
+	switch c.ContentType() {
+	case "text/csv":
+		format = model.PriceImportFormatCSV
+	case "application/x-ndjson", "application/jsonl":
+		format = model.PriceImportFormatNDJSON
+	default:
+		libs.HandleBadRequestError(c, "Content-Type must be text/csv or application/x-ndjson")
+		return
+	}
+
+	result, err := h.PriceService.ImportPrices(c.Request.Body, format)
+
+	// Prices of many securities may have changed, also if import failed midway
+	if result != nil && result.Imported > 0 {
+		h.CacheService.Purge()
+	}
+
+	if err != nil {
+		if result == nil {
+			libs.HandleBadRequestError(c, err.Error())
+			return
+		}
+		code := http.StatusBadRequest
+		c.JSON(code, gin.H{"statusCode": code, "error": http.StatusText(code), "message": err.Error(), "result": result})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
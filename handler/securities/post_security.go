@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
 )
 
@@ -16,9 +17,10 @@ func (h *securitiesHandler) PostSecurity(c *gin.Context) {
 		return
 	}
 
-	security, err := h.SecurityService.CreateSecurity(&request)
+	security, err := h.SecurityService.CreateSecurity(&request, middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
-		panic(err)
+		libs.HandleBadRequestError(c, err.Error())
+		return
 	}
 
 	c.JSON(http.StatusCreated, security)
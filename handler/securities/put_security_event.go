@@ -0,0 +1,47 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// PutSecurityEvent updates event of security
+func (h *securitiesHandler) PutSecurityEvent(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	var input model.SecurityEventInput
+	if err := c.BindJSON(&input); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	event, err := h.EventService.UpdateSecurityEvent(securityUuid, uint(id), &input)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	h.PriceService.InvalidatePriceAdjustments(securityUuid.String())
+	h.CacheService.InvalidateTags(model.SecurityCacheTag(securityUuid))
+
+	c.JSON(http.StatusOK, event)
+}
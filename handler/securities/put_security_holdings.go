@@ -0,0 +1,67 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// maxSecurityHoldingsBytes limits size of uploaded holdings
+const maxSecurityHoldingsBytes = 10 << 20
+
+// PutSecurityHoldings replaces constituents of fund by holdings of CSV or JSON body,
+// date of holdings is given by query parameter asOf (required for CSV)
+func (h *securitiesHandler) PutSecurityHoldings(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	type Query struct {
+		AsOf string `form:"asOf" binding:"omitempty,DateYYYY-MM-DD"`
+	}
+	var q Query
+	if err := c.BindQuery(&q); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+	var asOf *model.Date
+	if q.AsOf != "" {
+		t, err := time.Parse("2006-01-02", q.AsOf)
+		if err != nil {
+			libs.HandleBadRequestError(c, "asOf is not a valid date")
+			return
+		}
+		asOf = (*model.Date)(&t)
+	}
+
+	var format model.SecurityHoldingsFormat
+	switch c.ContentType() {
+	case "text/csv":
+		format = model.SecurityHoldingsFormatCSV
+	case "application/json":
+		format = model.SecurityHoldingsFormatJSON
+	default:
+		libs.HandleBadRequestError(c, "Content-Type must be text/csv or application/json")
+		return
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxSecurityHoldingsBytes)
+	holdings, err := h.SecurityService.UpdateSecurityHoldings(securityUuid, body, format, asOf)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, holdings)
+}
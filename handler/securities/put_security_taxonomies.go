@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
 	"github.com/shopspring/decimal"
 )
@@ -43,7 +44,8 @@ func (h *securitiesHandler) PutSecurityTaxonomies(c *gin.Context) {
 		}
 	}
 
-	ret, err := h.SecurityService.UpdateSecurityTaxonomies(securityUuid, taxonomyRootUuid, inputs)
+	ret, err := h.SecurityService.UpdateSecurityTaxonomies(securityUuid, taxonomyRootUuid, inputs,
+		middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,49 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/handler/middleware"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// RevertSecurity restores master data of security before change with version
+func (h *securitiesHandler) RevertSecurity(c *gin.Context) {
+	securityUuid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	security, err := h.SecurityService.RevertSecurity(securityUuid, version,
+		middleware.UserFromContext(c.Request.Context()))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	// Markets may have been removed or restored
+	h.PriceService.InvalidatePriceAdjustments(securityUuid.String())
+
+	// Security did not exist before change
+	if security == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, security)
+}
@@ -1,14 +1,16 @@
 package securities
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"net/http"
-	"os"
-	"strconv"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/db"
 	"github.com/portfolio-report/pr-api/graph/model"
 	"github.com/portfolio-report/pr-api/libs/tokenize"
@@ -24,12 +26,16 @@ type searchSecuritiesResponse struct {
 	SymbolXfra   *string                          `json:"symbolXfra"`
 	SymbolXnas   *string                          `json:"symbolXnas"`
 	SymbolXnys   *string                          `json:"symbolXnys"`
+	Identifiers  []securityIdentifierResponse     `json:"identifiers"`
+	Aliases      []securityAliasResponse          `json:"aliases"`
 	SecurityType *string                          `json:"securityType"`
 	Markets      []searchSecuritiesResponseMarket `json:"markets"`
 	Tags         []string                         `json:"tags"`
+	LogoURL      *string                          `json:"logoUrl"`
+	LogoThumbURL *string                          `json:"logoThumbUrl"`
 }
 
-func searchSecuritiesResponseFromDB(s db.Security) searchSecuritiesResponse {
+func searchSecuritiesResponseFromDB(s db.Security, logo model.SecurityLogo) searchSecuritiesResponse {
 	securityMarkets := []searchSecuritiesResponseMarket{}
 	for _, mDb := range s.SecurityMarkets {
 		securityMarkets = append(securityMarkets, searchSecuritiesResponseMarketFromDB(mDb))
@@ -45,15 +51,51 @@ func searchSecuritiesResponseFromDB(s db.Security) searchSecuritiesResponse {
 		Name:         s.Name,
 		Isin:         s.Isin,
 		Wkn:          s.Wkn,
-		SymbolXfra:   s.SymbolXfra,
-		SymbolXnas:   s.SymbolXnas,
-		SymbolXnys:   s.SymbolXnys,
+		SymbolXfra:   s.Ticker("XFRA"),
+		SymbolXnas:   s.Ticker("XNAS"),
+		SymbolXnys:   s.Ticker("XNYS"),
+		Identifiers:  securityIdentifiersResponseFromDB(s.Identifiers),
+		Aliases:      securityAliasesResponseFromDB(s.Aliases),
 		SecurityType: s.SecurityType,
 		Markets:      securityMarkets,
 		Tags:         tags,
+		LogoURL:      logo.LogoURL,
+		LogoThumbURL: logo.LogoThumbURL,
 	}
 }
 
+type securityIdentifierResponse struct {
+	Scheme string  `json:"scheme"`
+	Mic    *string `json:"mic"`
+	Value  string  `json:"value"`
+}
+
+func securityIdentifiersResponseFromDB(identifiers []db.SecurityIdentifier) []securityIdentifierResponse {
+	ret := []securityIdentifierResponse{}
+	for _, i := range identifiers {
+		identifier := securityIdentifierResponse{Scheme: i.Scheme, Value: i.Value}
+		if i.Mic != "" {
+			mic := i.Mic
+			identifier.Mic = &mic
+		}
+		ret = append(ret, identifier)
+	}
+	return ret
+}
+
+type securityAliasResponse struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+func securityAliasesResponseFromDB(aliases []db.SecurityAlias) []securityAliasResponse {
+	ret := []securityAliasResponse{}
+	for _, a := range aliases {
+		ret = append(ret, securityAliasResponse{Kind: a.Kind, Name: a.Name})
+	}
+	return ret
+}
+
 type searchSecuritiesResponseMarket struct {
 	MarketCode     string      `json:"marketCode"`
 	Symbol         *string     `json:"symbol"`
@@ -72,73 +114,270 @@ func searchSecuritiesResponseMarketFromDB(s db.SecurityMarket) searchSecuritiesR
 	}
 }
 
-// SearchSecurities lists securities matching the search query
-func (h *securitiesHandler) SearchSecurities(c *gin.Context) {
-	searchTerm := c.Param("searchTerm")
-	securityType := c.Query("securityType")
+// securitySearch is search query parsed into search term and filters
+type securitySearch struct {
+	Term       string
+	Tags       []string
+	Types      []string
+	Markets    []string
+	Currencies []string
+	Isins      []string
+}
+
+// parseSecuritySearch splits query into search term and filters given as key:value,
+// filters with same key match any of their values except tags, which must all
+// match (as always in /search/:searchTerm). Unknown keys are ignored.
+func parseSecuritySearch(query string, securityType string) securitySearch {
+	tokens, keyValues := tokenize.ParseKeyValue(tokenize.SplitByWhitespace(query))
+
+	search := securitySearch{Term: strings.ToUpper(strings.Join(tokens, " "))}
+	if securityType != "" {
+		search.Types = append(search.Types, securityType)
+	}
+	for _, kv := range keyValues {
+		switch strings.ToLower(kv[0]) {
+		case "tag":
+			search.Tags = append(search.Tags, strings.ToLower(kv[1]))
+		case "type":
+			search.Types = append(search.Types, kv[1])
+		case "market":
+			search.Markets = append(search.Markets, strings.ToUpper(kv[1]))
+		case "currency":
+			search.Currencies = append(search.Currencies, strings.ToUpper(kv[1]))
+		case "isin":
+			search.Isins = append(search.Isins, strings.ToUpper(kv[1]))
+		}
+	}
+	return search
+}
+
+// searchSecuritiesQuery returns query of securities matching search
+func (h *securitiesHandler) searchSecuritiesQuery(search securitySearch) *gorm.DB {
+	query := h.DB.Table("securities")
+
+	if len(search.Types) > 0 {
+		query = query.Where("security_type IN ?", search.Types)
+	}
+	for _, tag := range search.Tags {
+		query = query.Where("uuid IN (?)", h.DB.Table("securities_tags st").
+			Select("st.security_uuid").
+			Joins("INNER JOIN tags t ON t.uuid = st.tag_uuid").
+			Where("LOWER(t.name) = ?", tag))
+	}
+	if len(search.Markets) > 0 {
+		query = query.Where("uuid IN (?)", h.DB.Table("securities_markets").
+			Select("security_uuid").
+			Where("market_code IN ?", search.Markets))
+	}
+	if len(search.Currencies) > 0 {
+		query = query.Where("uuid IN (?)", h.DB.Table("securities_markets").
+			Select("security_uuid").
+			Where("currency_code IN ?", search.Currencies))
+	}
+	if len(search.Isins) > 0 {
+		query = query.Where("isin IN ? OR uuid IN (?)", search.Isins, h.DB.Table("securities_identifiers").
+			Select("security_uuid").
+			Where("scheme = 'isin' AND value IN ?", search.Isins))
+	}
+
+	if len(search.Term) > 0 {
+		exact, prefix, words, similar := h.searchTermConditions(search.Term)
+		query = query.Where("(?) OR (?) OR (?) OR (?)", exact, prefix, words, similar)
+	}
+
+	return query
+}
+
+// likeEscaper escapes wildcards of LIKE patterns
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchTermConditions returns conditions matching search term exactly to ISIN, WKN
+// or identifiers of all schemes, matching their prefix, matching all words of term
+// in name or aliases by full-text search, and matching name or aliases by trigram
+// similarity. Names are matched accent- and case-insensitive. Tickers may be
+// restricted to market by TICKER@MIC, which is matched exactly only.
+func (h *securitiesHandler) searchTermConditions(term string) (exact, prefix, words, similar clause.Expr) {
+	identifiers := func(condition string, vars ...interface{}) *gorm.DB {
+		return h.DB.Table("securities_identifiers si").
+			Select("1").
+			Where("si.security_uuid = securities.uuid").
+			Where(condition, vars...)
+	}
+	aliases := func(condition string, vars ...interface{}) *gorm.DB {
+		return h.DB.Table("securities_aliases sa").
+			Select("1").
+			Where("sa.security_uuid = securities.uuid").
+			Where(condition, vars...)
+	}
+
+	similar = clause.Expr{
+		SQL: "LOWER(immutable_unaccent(name)) % LOWER(immutable_unaccent(?)) OR EXISTS (?)",
+		Vars: []interface{}{term, aliases(
+			"LOWER(immutable_unaccent(sa.name)) % LOWER(immutable_unaccent(?))", term)},
+	}
+
+	words = clause.Expr{SQL: "FALSE"}
+	if tsquery := fullTextQuery(term); tsquery != "" {
+		words = clause.Expr{
+			SQL: "to_tsvector('simple', immutable_unaccent(name)) @@ to_tsquery('simple', immutable_unaccent(?)) OR EXISTS (?)",
+			Vars: []interface{}{tsquery, aliases(
+				"to_tsvector('simple', immutable_unaccent(sa.name)) @@ to_tsquery('simple', immutable_unaccent(?))", tsquery)},
+		}
+	}
 
-	searchTokensRaw := tokenize.SplitByWhitespace(searchTerm)
-	searchTokens, searchKeyValues := tokenize.ParseKeyValue(searchTokensRaw)
+	if ticker, mic, found := strings.Cut(term, "@"); found {
+		exact = clause.Expr{
+			SQL:  "EXISTS (?)",
+			Vars: []interface{}{identifiers("si.scheme = 'ticker' AND si.value = ? AND si.mic = ?", ticker, mic)},
+		}
+		prefix = clause.Expr{SQL: "FALSE"}
+		return
+	}
 
-	searchTerm = strings.ToUpper(strings.Join(searchTokens, " "))
+	exact = clause.Expr{
+		SQL:  "isin = ? OR wkn = ? OR EXISTS (?)",
+		Vars: []interface{}{term, term, identifiers("si.value = ?", term)},
+	}
 
-	maxResults, err := strconv.Atoi(os.Getenv("SECURITIES_SEARCH_MAX_RESULTS"))
+	// Single characters would match too many identifiers
+	prefix = clause.Expr{SQL: "FALSE"}
+	if len(term) >= 2 {
+		pattern := likeEscaper.Replace(term) + "%"
+		prefix = clause.Expr{
+			SQL:  "isin LIKE ? OR wkn LIKE ? OR EXISTS (?)",
+			Vars: []interface{}{pattern, pattern, identifiers("si.value LIKE ?", pattern)},
+		}
+	}
+	return
+}
+
+// searchNameDistance returns trigram distance of search term to name or closest
+// alias of security (accent- and case-insensitive), 1 if there is no name
+func (h *securitiesHandler) searchNameDistance(term string) clause.Expr {
+	return clause.Expr{
+		SQL: "LEAST(COALESCE((LOWER(immutable_unaccent(name)) <-> LOWER(immutable_unaccent(?)))::float8, 1), " +
+			"COALESCE((?)::float8, 1))",
+		Vars: []interface{}{term, h.DB.Table("securities_aliases sa").
+			Select("MIN(LOWER(immutable_unaccent(sa.name)) <-> LOWER(immutable_unaccent(?)))", term).
+			Where("sa.security_uuid = securities.uuid")},
+	}
+}
+
+// fullTextQuery returns tsquery matching all words of term, words of at least
+// three characters are matched as prefix. Returns empty string if term has no words.
+func fullTextQuery(term string) string {
+	var parts []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(term), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if utf8.RuneCountInString(word) >= 3 {
+			word += ":*"
+		}
+		parts = append(parts, word)
+	}
+	return strings.Join(parts, " & ")
+}
+
+// searchSecuritiesCursor is position in search results, i.e. sort key of last result
+type searchSecuritiesCursor struct {
+	Rank float64 `json:"r"`
+	Name string  `json:"n"`
+	UUID string  `json:"u"`
+}
+
+func (c searchSecuritiesCursor) String() string {
+	data, err := json.Marshal(c)
 	if err != nil {
-		maxResults = 10
+		panic(err)
 	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
 
-	var securities []db.Security
-	query := h.DB.Preload("SecurityMarkets").Preload("Tags")
+func parseSearchSecuritiesCursor(s string) (*searchSecuritiesCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	var cursor searchSecuritiesCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	return &cursor, nil
+}
 
-	if securityType != "" {
-		query = query.Where("security_type = ?", securityType)
-	}
-
-	// Filter securities by key value pairs, if given
-	for _, kv := range searchKeyValues {
-		if strings.ToLower(kv[0]) == "tag" {
-			var tag db.Tag
-			var securityUuids []uuid.UUID
-
-			if err := h.DB.Preload("Securities").Take(&tag, "LOWER(name) = LOWER(?)", kv[1]).Error; err != nil {
-				if errors.Is(err, gorm.ErrRecordNotFound) {
-					securityUuids = []uuid.UUID{}
-				} else {
-					panic(err)
-				}
-			} else {
-				securityUuids = make([]uuid.UUID, len(tag.Securities))
-				for i := range tag.Securities {
-					securityUuids[i] = tag.Securities[i].UUID
-
-				}
-			}
-
-			query = query.Where("uuid IN ?", securityUuids)
-			maxResults = 0
-		} else {
-			// ignore unknown tags
+// searchSecurities returns securities matching search sorted by rank of match,
+// then by name, starting after cursor. Cursor of next page is
+// returned if there are more results. All results are returned if limit is 0.
+func (h *securitiesHandler) searchSecurities(search securitySearch, cursor *searchSecuritiesCursor, limit int) ([]db.Security, *searchSecuritiesCursor) {
+	// Exact identifier matches rank before prefix matches before names containing
+	// all words before similar names, each ordered by similarity of name or alias
+	rank := clause.Expr{SQL: "0::float8"}
+	if len(search.Term) > 0 {
+		exact, prefix, words, _ := h.searchTermConditions(search.Term)
+		rank = clause.Expr{
+			SQL:  "CASE WHEN (?) THEN 0 WHEN (?) THEN 1 WHEN (?) THEN 2 ELSE 3 END + ?",
+			Vars: []interface{}{exact, prefix, words, h.searchNameDistance(search.Term)},
 		}
 	}
 
-	if len(searchTerm) > 0 {
-		query = query.Where("isin = ? OR wkn = ? OR symbol_xfra = ? OR symbol_xnas = ? OR symbol_xnys = ? OR name % ?", searchTerm, searchTerm, searchTerm, searchTerm, searchTerm, searchTerm)
-		query = query.Clauses(clause.OrderBy{
-			Expression: clause.Expr{SQL: "name <-> ?", Vars: []interface{}{searchTerm}, WithoutParentheses: true},
-		})
+	query := h.searchSecuritiesQuery(search).
+		Select("? AS rank, COALESCE(name, '') AS name, uuid::text AS uuid", rank).
+		Order("rank, name, uuid")
+	if cursor != nil {
+		query = query.Where("(?, COALESCE(name, ''), uuid::text) > (?, ?, ?)", rank, cursor.Rank, cursor.Name, cursor.UUID)
+	}
+	if limit != 0 {
+		query = query.Limit(limit + 1)
+	}
+
+	var keys []searchSecuritiesCursor
+	if err := query.Scan(&keys).Error; err != nil {
+		panic(err)
 	}
 
-	if maxResults != 0 {
-		query = query.Limit(maxResults)
+	var next *searchSecuritiesCursor
+	if limit != 0 && len(keys) > limit {
+		keys = keys[:limit]
+		next = &keys[limit-1]
 	}
 
-	if err := query.Find(&securities).Error; err != nil {
+	uuids := make([]string, len(keys))
+	for i := range keys {
+		uuids[i] = keys[i].UUID
+	}
+
+	var securities []db.Security
+	if err := h.DB.Preload("SecurityMarkets").Preload("Tags").Scopes(db.PreloadIdentifiers, db.PreloadAliases).
+		Find(&securities, "uuid IN ?", uuids).Error; err != nil {
 		panic(err)
 	}
 
+	position := map[string]int{}
+	for i, u := range uuids {
+		position[u] = i
+	}
+	sort.Slice(securities, func(a, b int) bool {
+		return position[securities[a].UUID.String()] < position[securities[b].UUID.String()]
+	})
+
+	return securities, next
+}
+
+// SearchSecurities lists securities matching the search query
+func (h *securitiesHandler) SearchSecurities(c *gin.Context) {
+	search := parseSecuritySearch(c.Param("searchTerm"), c.Query("securityType"))
+
+	// All securities with tag are returned
+	maxResults := h.searchMaxResults
+	if len(search.Tags) > 0 {
+		maxResults = 0
+	}
+
+	securities, _ := h.searchSecurities(search, nil, maxResults)
+
 	response := []searchSecuritiesResponse{}
 	for _, s := range securities {
-		response = append(response, searchSecuritiesResponseFromDB(s))
+		response = append(response, searchSecuritiesResponseFromDB(s, h.SecurityService.LogoFromExtras(s.Extras)))
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -0,0 +1,105 @@
+package securities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/libs"
+	"gorm.io/gorm"
+)
+
+// maxSearchSecuritiesLimit limits number of securities per page
+const maxSearchSecuritiesLimit = 100
+
+type searchSecuritiesFacet struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// searchSecuritiesFacets counts values of query grouped by first column, most frequent first
+func searchSecuritiesFacets(query *gorm.DB) []searchSecuritiesFacet {
+	facets := []searchSecuritiesFacet{}
+	if err := query.Order("count DESC, value").Scan(&facets).Error; err != nil {
+		panic(err)
+	}
+	return facets
+}
+
+// SearchSecuritiesFaceted lists page of securities matching the search query
+// with total count and facets of all matching securities
+func (h *securitiesHandler) SearchSecuritiesFaceted(c *gin.Context) {
+	type Query struct {
+		Q            string `form:"q"`
+		SecurityType string `form:"securityType"`
+		Limit        int    `form:"limit" binding:"omitempty,min=1,max=100"`
+		Cursor       string `form:"cursor"`
+	}
+
+	var q Query
+	if err := c.BindQuery(&q); err != nil {
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	if q.Limit == 0 {
+		q.Limit = h.searchMaxResults
+		if q.Limit < 1 || q.Limit > maxSearchSecuritiesLimit {
+			q.Limit = maxSearchSecuritiesLimit
+		}
+	}
+
+	var cursor *searchSecuritiesCursor
+	if q.Cursor != "" {
+		var err error
+		if cursor, err = parseSearchSecuritiesCursor(q.Cursor); err != nil {
+			libs.HandleBadRequestError(c, err.Error())
+			return
+		}
+	}
+
+	search := parseSecuritySearch(q.Q, q.SecurityType)
+
+	securities, next := h.searchSecurities(search, cursor, q.Limit)
+
+	entries := []searchSecuritiesResponse{}
+	for _, s := range securities {
+		entries = append(entries, searchSecuritiesResponseFromDB(s, h.SecurityService.LogoFromExtras(s.Extras)))
+	}
+
+	var nextCursor *string
+	if next != nil {
+		s := next.String()
+		nextCursor = &s
+	}
+
+	var totalCount int64
+	if err := h.searchSecuritiesQuery(search).Count(&totalCount).Error; err != nil {
+		panic(err)
+	}
+
+	facets := gin.H{
+		"securityType": searchSecuritiesFacets(h.searchSecuritiesQuery(search).
+			Select("security_type AS value, COUNT(*) AS count").
+			Where("security_type IS NOT NULL").
+			Group("security_type")),
+		"market": searchSecuritiesFacets(h.DB.Table("securities_markets").
+			Select("market_code AS value, COUNT(*) AS count").
+			Where("security_uuid IN (?)", h.searchSecuritiesQuery(search).Select("uuid")).
+			Group("market_code")),
+		"tag": searchSecuritiesFacets(h.DB.Table("securities_tags st").
+			Select("t.name AS value, COUNT(*) AS count").
+			Joins("INNER JOIN tags t ON t.uuid = st.tag_uuid").
+			Where("st.security_uuid IN (?)", h.searchSecuritiesQuery(search).Select("uuid")).
+			Group("t.name")),
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"entries": entries,
+		"params": gin.H{
+			"totalCount": totalCount,
+			"limit":      q.Limit,
+			"nextCursor": nextCursor,
+		},
+		"facets": facets,
+	})
+}
@@ -0,0 +1,32 @@
+package securities
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+)
+
+// UnacknowledgePriceFinding reopens acknowledged finding of price data quality checks
+func (h *securitiesHandler) UnacknowledgePriceFinding(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		libs.HandleNotFoundError(c)
+		return
+	}
+
+	finding, err := h.PriceService.UnacknowledgePriceFinding(uint(id))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			libs.HandleNotFoundError(c)
+			return
+		}
+		libs.HandleBadRequestError(c, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, finding)
+}
@@ -3,15 +3,16 @@ package securities
 import (
 	"errors"
 	"net/http"
-	"path/filepath"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
+	"github.com/portfolio-report/pr-api/service"
 	"gorm.io/gorm"
 )
 
-// UpdateLogo stores new/changed logo of security
+// UpdateLogo stores new/changed logo of security, format is detected by content
 func (h *securitiesHandler) UpdateLogo(c *gin.Context) {
 	uuid, err := uuid.Parse(c.Param("uuid"))
 	if err != nil {
@@ -25,27 +26,27 @@ func (h *securitiesHandler) UpdateLogo(c *gin.Context) {
 		return
 	}
 
-	extension := filepath.Ext(file.Filename)
-	if extension != ".jpg" && extension != ".png" {
-		libs.HandleBadRequestError(c, "Unknown file extension")
-		return
-	}
-
 	openedFile, err := file.Open()
 	if err != nil {
 		panic(err)
 	}
 	defer openedFile.Close()
 
-	logoUrl, err := h.SecurityService.UpdateLogo(uuid, openedFile, extension)
+	logo, err := h.SecurityService.UpdateLogo(uuid, openedFile,
+		middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			libs.HandleNotFoundError(c)
 			return
 		}
+		if errors.Is(err, service.ErrLogoStoreUnavailable) {
+			libs.HandleServiceUnavailableError(c, err.Error())
+			return
+		}
 
-		panic(err)
+		libs.HandleBadRequestError(c, err.Error())
+		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"logoUrl": logoUrl})
+	c.JSON(http.StatusOK, logo)
 }
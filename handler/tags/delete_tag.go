@@ -4,13 +4,14 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 )
 
 // DeleteTag removes tag
 func (h *tagsHandler) DeleteTag(c *gin.Context) {
 	name := c.Param("name")
 
-	h.SecurityService.DeleteTag(name)
+	h.SecurityService.DeleteTag(name, middleware.UserFromContext(c.Request.Context()))
 
 	c.Status(http.StatusNoContent)
 }
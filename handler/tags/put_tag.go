@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/handler/middleware"
 	"github.com/portfolio-report/pr-api/libs"
 )
 
@@ -31,7 +32,7 @@ func (h *tagsHandler) PutTag(c *gin.Context) {
 		uuids[i] = request.Securities[i].UUID
 	}
 
-	securities, err := h.SecurityService.UpsertTag(name, uuids)
+	securities, err := h.SecurityService.UpsertTag(name, uuids, middleware.UserFromContext(c.Request.Context()))
 	if err != nil {
 		libs.HandleBadRequestError(c, err.Error())
 		return
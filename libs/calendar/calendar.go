@@ -0,0 +1,410 @@
+// Package calendar provides trading calendars with weekends and holidays of
+// markets. Calendars are defined by rules in embedded data files, so holidays
+// can be calculated for any year.
+package calendar
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed data/*.json
+var dataFiles embed.FS
+
+// DefaultCode is the code of calendar used for unknown markets
+const DefaultCode = "default"
+
+// Holiday is a day without trading
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// Calendar describes trading days of one or more markets
+type Calendar struct {
+	Code    string
+	Name    string
+	Markets []string
+
+	weekend map[time.Weekday]bool
+	rules   []rule
+
+	mu       sync.Mutex
+	holidays map[int]map[string]Holiday
+}
+
+// rule describes a holiday as defined in data files
+type rule struct {
+	Name string `json:"name"`
+
+	// Fixed date, e.g. Christmas
+	Month int `json:"month"`
+	Day   int `json:"day"`
+
+	// Nth weekday of month (negative counts from end of month or from Day if set)
+	Weekday *time.Weekday `json:"-"`
+	Nth     int           `json:"nth"`
+
+	// Offset in days from Easter Sunday
+	Easter *int `json:"easter"`
+
+	// Single date, e.g. special closing
+	Date string `json:"date"`
+
+	// Observed moves holidays on weekends, see observe()
+	Observed string `json:"observed"`
+
+	// Range of years where rule is valid
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+// calendarFile is the structure of data files
+type calendarFile struct {
+	Code     string   `json:"code"`
+	Name     string   `json:"name"`
+	Markets  []string `json:"markets"`
+	Weekend  []string `json:"weekend"`
+	Holidays []struct {
+		rule
+		Weekday string `json:"weekday"`
+	} `json:"holidays"`
+}
+
+var (
+	calendars       map[string]*Calendar
+	calendarsMarket map[string]*Calendar
+)
+
+func init() {
+	var err error
+	calendars, calendarsMarket, err = load()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// load reads all calendars from embedded data files
+func load() (map[string]*Calendar, map[string]*Calendar, error) {
+	entries, err := dataFiles.ReadDir("data")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byCode := map[string]*Calendar{}
+	byMarket := map[string]*Calendar{}
+	for _, e := range entries {
+		bytes, err := dataFiles.ReadFile(path.Join("data", e.Name()))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var f calendarFile
+		if err := json.Unmarshal(bytes, &f); err != nil {
+			return nil, nil, fmt.Errorf("invalid calendar %s: %w", e.Name(), err)
+		}
+
+		c, err := newCalendar(f)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid calendar %s: %w", e.Name(), err)
+		}
+		if _, exists := byCode[c.Code]; exists {
+			return nil, nil, fmt.Errorf("duplicate calendar %s", c.Code)
+		}
+		byCode[c.Code] = c
+		for _, m := range c.Markets {
+			byMarket[strings.ToUpper(m)] = c
+		}
+	}
+
+	if _, ok := byCode[DefaultCode]; !ok {
+		return nil, nil, fmt.Errorf("calendar %s is missing", DefaultCode)
+	}
+
+	return byCode, byMarket, nil
+}
+
+// newCalendar creates calendar from data file
+func newCalendar(f calendarFile) (*Calendar, error) {
+	c := &Calendar{
+		Code:     f.Code,
+		Name:     f.Name,
+		Markets:  f.Markets,
+		weekend:  map[time.Weekday]bool{},
+		holidays: map[int]map[string]Holiday{},
+	}
+
+	for _, w := range f.Weekend {
+		weekday, err := parseWeekday(w)
+		if err != nil {
+			return nil, err
+		}
+		c.weekend[weekday] = true
+	}
+
+	for _, h := range f.Holidays {
+		r := h.rule
+		if h.Weekday != "" {
+			weekday, err := parseWeekday(h.Weekday)
+			if err != nil {
+				return nil, err
+			}
+			r.Weekday = &weekday
+		}
+
+		switch {
+		case r.Date != "":
+			if _, err := time.Parse("2006-01-02", r.Date); err != nil {
+				return nil, err
+			}
+		case r.Easter != nil:
+		case r.Month >= 1 && r.Month <= 12 && (r.Day > 0 || r.Weekday != nil):
+		default:
+			return nil, fmt.Errorf("invalid rule for holiday %s", r.Name)
+		}
+
+		c.rules = append(c.rules, r)
+	}
+
+	return c, nil
+}
+
+// parseWeekday converts English name of weekday
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), s) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %s", s)
+}
+
+// Get returns calendar by code
+func Get(code string) (*Calendar, bool) {
+	c, ok := calendars[code]
+	return c, ok
+}
+
+// Default returns calendar with weekends only
+func Default() *Calendar {
+	return calendars[DefaultCode]
+}
+
+// ForMarket returns calendar of market, falls back to default calendar
+func ForMarket(marketCode string) *Calendar {
+	if c, ok := calendarsMarket[strings.ToUpper(marketCode)]; ok {
+		return c
+	}
+	return Default()
+}
+
+// Codes lists codes of all available calendars
+func Codes() []string {
+	codes := make([]string, 0, len(calendars))
+	for code := range calendars {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Holidays lists holidays of year sorted by date, holidays on weekends are omitted
+func (c *Calendar) Holidays(year int) []Holiday {
+	holidays := c.holidaysOfYear(year)
+
+	ret := make([]Holiday, 0, len(holidays))
+	for _, h := range holidays {
+		ret = append(ret, h)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Date.Before(ret[j].Date) })
+	return ret
+}
+
+// IsWeekend checks if date is on weekend
+func (c *Calendar) IsWeekend(t time.Time) bool {
+	return c.weekend[t.Weekday()]
+}
+
+// IsHoliday checks if date is holiday
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	_, ok := c.holidaysOfYear(t.Year())[t.Format("2006-01-02")]
+	return ok
+}
+
+// IsTradingDay checks if date is neither on weekend nor holiday
+func (c *Calendar) IsTradingDay(t time.Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// CountTradingDays counts trading days between from and to (both inclusive)
+func (c *Calendar) CountTradingDays(from, to time.Time) int {
+	count := 0
+	for d := truncate(from); !d.After(to); d = d.AddDate(0, 0, 1) {
+		if c.IsTradingDay(d) {
+			count++
+		}
+	}
+	return count
+}
+
+// TradingDays lists trading days between from and to (both inclusive)
+func (c *Calendar) TradingDays(from, to time.Time) []time.Time {
+	days := []time.Time{}
+	for d := truncate(from); !d.After(to); d = d.AddDate(0, 0, 1) {
+		if c.IsTradingDay(d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// NextTradingDay returns first trading day on or after date
+func (c *Calendar) NextTradingDay(t time.Time) time.Time {
+	d := truncate(t)
+	for !c.IsTradingDay(d) {
+		d = d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// PreviousTradingDay returns last trading day on or before date
+func (c *Calendar) PreviousTradingDay(t time.Time) time.Time {
+	d := truncate(t)
+	for !c.IsTradingDay(d) {
+		d = d.AddDate(0, 0, -1)
+	}
+	return d
+}
+
+// holidaysOfYear returns (cached) holidays of year by date
+func (c *Calendar) holidaysOfYear(year int) map[string]Holiday {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if holidays, ok := c.holidays[year]; ok {
+		return holidays
+	}
+
+	holidays := map[string]Holiday{}
+	for _, r := range c.rules {
+		if (r.From != 0 && year < r.From) || (r.To != 0 && year > r.To) {
+			continue
+		}
+
+		date, ok := r.date(year)
+		if !ok {
+			continue
+		}
+		date, ok = c.observe(date, r.Observed, holidays)
+		if !ok || date.Year() != year {
+			continue
+		}
+		if c.IsWeekend(date) {
+			continue
+		}
+
+		key := date.Format("2006-01-02")
+		if _, exists := holidays[key]; !exists {
+			holidays[key] = Holiday{Date: date, Name: r.Name}
+		}
+	}
+
+	c.holidays[year] = holidays
+	return holidays
+}
+
+// observe moves holidays on weekends according to rule:
+//   - nearestWeekday: Saturday to Friday, Sunday to Monday
+//   - sundayToMonday: Sunday to Monday, Saturday is omitted
+//   - substitute: next weekday that is not already a holiday (also if date is
+//     taken by another holiday)
+func (c *Calendar) observe(date time.Time, observed string, holidays map[string]Holiday) (time.Time, bool) {
+	_, taken := holidays[date.Format("2006-01-02")]
+	if !c.IsWeekend(date) && !(taken && observed == "substitute") {
+		return date, true
+	}
+
+	switch observed {
+	case "nearestWeekday":
+		switch date.Weekday() {
+		case time.Saturday:
+			return date.AddDate(0, 0, -1), true
+		case time.Sunday:
+			return date.AddDate(0, 0, 1), true
+		}
+	case "sundayToMonday":
+		if date.Weekday() == time.Sunday {
+			return date.AddDate(0, 0, 1), true
+		}
+	case "substitute":
+		for {
+			date = date.AddDate(0, 0, 1)
+			if _, taken = holidays[date.Format("2006-01-02")]; !taken && !c.IsWeekend(date) {
+				return date, true
+			}
+		}
+	}
+
+	return date, false
+}
+
+// date calculates date of holiday in year
+func (r *rule) date(year int) (time.Time, bool) {
+	switch {
+	case r.Date != "":
+		d, _ := time.Parse("2006-01-02", r.Date)
+		return d, d.Year() == year
+
+	case r.Easter != nil:
+		return easterSunday(year).AddDate(0, 0, *r.Easter), true
+
+	case r.Weekday != nil && r.Nth > 0:
+		d := time.Date(year, time.Month(r.Month), 1, 0, 0, 0, 0, time.UTC)
+		offset := (int(*r.Weekday) - int(d.Weekday()) + 7) % 7
+		return d.AddDate(0, 0, offset+7*(r.Nth-1)), true
+
+	case r.Weekday != nil && r.Nth < 0:
+		// Count backwards from Day or end of month
+		var d time.Time
+		if r.Day > 0 {
+			d = time.Date(year, time.Month(r.Month), r.Day, 0, 0, 0, 0, time.UTC)
+		} else {
+			d = time.Date(year, time.Month(r.Month)+1, 0, 0, 0, 0, 0, time.UTC)
+		}
+		offset := (int(d.Weekday()) - int(*r.Weekday) + 7) % 7
+		return d.AddDate(0, 0, -offset+7*(r.Nth+1)), true
+
+	default:
+		return time.Date(year, time.Month(r.Month), r.Day, 0, 0, 0, 0, time.UTC), true
+	}
+}
+
+// easterSunday calculates date of Easter Sunday (Gregorian calendar)
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// truncate removes time of day
+func truncate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,109 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestEasterSunday(t *testing.T) {
+	testCases := map[int]string{
+		2000: "2000-04-23",
+		2019: "2019-04-21",
+		2022: "2022-04-17",
+		2024: "2024-03-31",
+		2038: "2038-04-25",
+	}
+	for year, expected := range testCases {
+		assert.Equal(t, date(expected), easterSunday(year), year)
+	}
+}
+
+func TestGet(t *testing.T) {
+	for _, code := range Codes() {
+		c, ok := Get(code)
+		require.True(t, ok)
+		assert.Equal(t, code, c.Code)
+	}
+
+	_, ok := Get("unknown")
+	assert.False(t, ok)
+
+	assert.Equal(t, "nyse", ForMarket("xnys").Code)
+	assert.Equal(t, "de", ForMarket("XETR").Code)
+	assert.Equal(t, DefaultCode, ForMarket("UNKNOWN").Code)
+}
+
+func TestHolidays(t *testing.T) {
+	testCases := []struct {
+		code     string
+		year     int
+		expected []string
+	}{
+		{"default", 2022, []string{}},
+		{"de", 2022, []string{"2022-04-15", "2022-04-18", "2022-12-26"}},
+		{"nyse", 2022, []string{
+			"2022-01-17", "2022-02-21", "2022-04-15", "2022-05-30", "2022-06-20",
+			"2022-07-04", "2022-09-05", "2022-11-24", "2022-12-26",
+		}},
+		{"nyse", 2021, []string{
+			"2021-01-01", "2021-01-18", "2021-02-15", "2021-04-02", "2021-05-31",
+			"2021-07-05", "2021-09-06", "2021-11-25", "2021-12-24",
+		}},
+		{"lse", 2022, []string{
+			"2022-01-03", "2022-04-15", "2022-04-18", "2022-05-02", "2022-06-02",
+			"2022-06-03", "2022-08-29", "2022-09-19", "2022-12-26", "2022-12-27",
+		}},
+		{"lse", 2021, []string{
+			"2021-01-01", "2021-04-02", "2021-04-05", "2021-05-03", "2021-05-31",
+			"2021-08-30", "2021-12-27", "2021-12-28",
+		}},
+		{"tsx", 2022, []string{
+			"2022-01-03", "2022-02-21", "2022-04-15", "2022-05-23", "2022-07-01",
+			"2022-08-01", "2022-09-05", "2022-10-10", "2022-12-26", "2022-12-27",
+		}},
+	}
+
+	for _, tc := range testCases {
+		c, ok := Get(tc.code)
+		require.True(t, ok)
+
+		dates := []string{}
+		for _, h := range c.Holidays(tc.year) {
+			dates = append(dates, h.Date.Format("2006-01-02"))
+			assert.NotEmpty(t, h.Name)
+		}
+		assert.Equal(t, tc.expected, dates, "%s %d", tc.code, tc.year)
+	}
+}
+
+func TestTradingDays(t *testing.T) {
+	c, _ := Get("de")
+
+	assert.True(t, c.IsTradingDay(date("2022-04-14")))
+	assert.False(t, c.IsTradingDay(date("2022-04-15")))
+	assert.False(t, c.IsTradingDay(date("2022-04-16")))
+	assert.True(t, c.IsWeekend(date("2022-04-17")))
+	assert.True(t, c.IsHoliday(date("2022-04-18")))
+
+	// Easter weekend: Thu before to Tue after
+	assert.Equal(t, 2, c.CountTradingDays(date("2022-04-14"), date("2022-04-19")))
+	assert.Equal(t,
+		[]time.Time{date("2022-04-14"), date("2022-04-19")},
+		c.TradingDays(date("2022-04-14"), date("2022-04-19")))
+	assert.Equal(t, 0, c.CountTradingDays(date("2022-04-15"), date("2022-04-18")))
+
+	assert.Equal(t, date("2022-04-14"), c.PreviousTradingDay(date("2022-04-18")))
+	assert.Equal(t, date("2022-04-19"), c.NextTradingDay(date("2022-04-15")))
+	assert.Equal(t, date("2022-04-19"), c.NextTradingDay(date("2022-04-19")))
+}
@@ -0,0 +1,70 @@
+package libs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewETag(t *testing.T) {
+	etag := NewETag("a", "b")
+	assert.Regexp(t, `^"[0-9a-f]{32}"$`, etag)
+	assert.Equal(t, etag, NewETag("a", "b"))
+	assert.NotEqual(t, etag, NewETag("ab"))
+	assert.NotEqual(t, etag, NewETag("b", "a"))
+}
+
+func TestHandleConditionalRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	etag := `"abc"`
+	lastModified := time.Date(2022, 5, 1, 12, 30, 15, 500, time.UTC)
+
+	testCases := []struct {
+		name        string
+		method      string
+		headers     map[string]string
+		notModified bool
+	}{
+		{"unconditional", "GET", nil, false},
+		{"matching etag", "GET", map[string]string{"If-None-Match": `"abc"`}, true},
+		{"matching weak etag", "GET", map[string]string{"If-None-Match": `W/"abc"`}, true},
+		{"matching etag in list", "HEAD", map[string]string{"If-None-Match": `"xyz", "abc"`}, true},
+		{"any etag", "GET", map[string]string{"If-None-Match": `*`}, true},
+		{"other etag", "GET", map[string]string{"If-None-Match": `"xyz"`}, false},
+		{"not modified since", "GET", map[string]string{"If-Modified-Since": "Sun, 01 May 2022 12:30:15 GMT"}, true},
+		{"modified since", "GET", map[string]string{"If-Modified-Since": "Sun, 01 May 2022 12:30:14 GMT"}, false},
+		{"invalid date", "GET", map[string]string{"If-Modified-Since": "yesterday"}, false},
+		{"etag takes precedence", "GET", map[string]string{
+			"If-None-Match":     `"xyz"`,
+			"If-Modified-Since": "Sun, 01 May 2022 12:30:15 GMT",
+		}, false},
+		{"unsafe method", "POST", map[string]string{"If-None-Match": `"abc"`}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(tc.method, "/", nil)
+			for k, v := range tc.headers {
+				c.Request.Header.Set(k, v)
+			}
+
+			handled := HandleConditionalRequest(c, etag, lastModified)
+			c.Writer.WriteHeaderNow()
+
+			assert.Equal(t, tc.notModified, handled)
+			assert.Equal(t, tc.notModified, c.IsAborted())
+			if tc.notModified {
+				assert.Equal(t, http.StatusNotModified, w.Code)
+			}
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+			assert.Equal(t, "Sun, 01 May 2022 12:30:15 GMT", w.Header().Get("Last-Modified"))
+		})
+	}
+}
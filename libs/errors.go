@@ -33,3 +33,24 @@ func HandleBadRequestError(c *gin.Context, msg string) {
 	c.JSON(code, gin.H{"statusCode": code, "error": http.StatusText(code), "message": msg})
 	c.Abort()
 }
+
+// HandleConflictError returns Conflict error with JSON body
+func HandleConflictError(c *gin.Context, msg string) {
+	code := http.StatusConflict
+	c.JSON(code, gin.H{"statusCode": code, "error": http.StatusText(code), "message": msg})
+	c.Abort()
+}
+
+// HandleTooManyRequestsError returns Too Many Requests error with JSON body
+func HandleTooManyRequestsError(c *gin.Context, msg string) {
+	code := http.StatusTooManyRequests
+	c.JSON(code, gin.H{"statusCode": code, "error": http.StatusText(code), "message": msg})
+	c.Abort()
+}
+
+// HandleServiceUnavailableError returns Service Unavailable error with JSON body
+func HandleServiceUnavailableError(c *gin.Context, msg string) {
+	code := http.StatusServiceUnavailable
+	c.JSON(code, gin.H{"statusCode": code, "error": http.StatusText(code), "message": msg})
+	c.Abort()
+}
@@ -0,0 +1,121 @@
+package libs
+
+import (
+	"regexp"
+	"strings"
+)
+
+// isinPrefixes are ISO 3166 country codes, former codes (ISO 3166-3) of ISINs
+// still in use and codes assigned to international securities, which ISINs may start with
+var isinPrefixes = func() map[string]bool {
+	codes := "AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI " +
+		"BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR " +
+		"CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA " +
+		"GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE " +
+		"IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC " +
+		"LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU " +
+		"MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM " +
+		"PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO " +
+		"SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM " +
+		"US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW " +
+		"AN BU CS DD SU TP YU ZR " +
+		"EU QS QT XA XB XC XD XS"
+	ret := map[string]bool{}
+	for _, code := range strings.Fields(codes) {
+		ret[code] = true
+	}
+	return ret
+}()
+
+var isinRegex = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{9}[0-9]$`)
+
+// wknRegex matches WKN, letters I and O are not used
+var wknRegex = regexp.MustCompile(`^[0-9A-HJ-NP-Z]{6}$`)
+
+var cusipRegex = regexp.MustCompile(`^[0-9A-Z*@#]{8}[0-9]$`)
+
+// alphanumericValue returns value of character used in check digits, i.e.
+// 0-9 for digits and 10-35 for letters
+func alphanumericValue(c byte) int {
+	if c >= '0' && c <= '9' {
+		return int(c - '0')
+	}
+	return int(c-'A') + 10
+}
+
+// IsValidIsin checks format, country prefix and check digit (Luhn) of ISIN
+func IsValidIsin(isin string) bool {
+	if !isinRegex.MatchString(isin) || !isinPrefixes[isin[:2]] {
+		return false
+	}
+
+	// Letters are expanded to two digits, check digit is included
+	var digits []int
+	for i := 0; i < len(isin); i++ {
+		v := alphanumericValue(isin[i])
+		if v >= 10 {
+			digits = append(digits, v/10)
+		}
+		digits = append(digits, v%10)
+	}
+
+	sum := 0
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			d *= 2
+		}
+		sum += d/10 + d%10
+	}
+	return sum%10 == 0
+}
+
+// IsValidWkn checks format of WKN (German securities identification number)
+func IsValidWkn(wkn string) bool {
+	return wknRegex.MatchString(wkn)
+}
+
+// IsValidCusip checks format and check digit of CUSIP
+func IsValidCusip(cusip string) bool {
+	if !cusipRegex.MatchString(cusip) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		var v int
+		switch c := cusip[i]; c {
+		case '*':
+			v = 36
+		case '@':
+			v = 37
+		case '#':
+			v = 38
+		default:
+			v = alphanumericValue(c)
+		}
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	return int(cusip[8]-'0') == (10-sum%10)%10
+}
+
+// WknFromIsin derives WKN from German ISIN (DE000 followed by WKN),
+// returns false if ISIN does not contain WKN
+func WknFromIsin(isin string) (string, bool) {
+	if !IsValidIsin(isin) || !strings.HasPrefix(isin, "DE000") || !IsValidWkn(isin[5:11]) {
+		return "", false
+	}
+	return isin[5:11], true
+}
+
+// CusipFromIsin derives CUSIP from ISIN of US or Canada (country code
+// followed by CUSIP), returns false if ISIN does not contain CUSIP
+func CusipFromIsin(isin string) (string, bool) {
+	if !IsValidIsin(isin) || (isin[:2] != "US" && isin[:2] != "CA") || !IsValidCusip(isin[2:11]) {
+		return "", false
+	}
+	return isin[2:11], true
+}
@@ -0,0 +1,51 @@
+package libs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidIsin(t *testing.T) {
+	a := assert.New(t)
+
+	for _, isin := range []string{"DE0007164600", "US0378331005", "XS0000000009", "AN8068571086", "CSHIPOL00005"} {
+		a.True(IsValidIsin(isin), isin)
+	}
+	for _, isin := range []string{"DE0007164601", "ZZ0000000008", "de0007164600", "DE000716460"} {
+		a.False(IsValidIsin(isin), isin)
+	}
+}
+
+func TestWknFromIsin(t *testing.T) {
+	a := assert.New(t)
+
+	wkn, ok := WknFromIsin("DE0007164600")
+	a.True(ok)
+	a.Equal("716460", wkn)
+
+	wkn, ok = WknFromIsin("DE000BASF111")
+	a.True(ok)
+	a.Equal("BASF11", wkn)
+
+	_, ok = WknFromIsin("DE0007164601")
+	a.False(ok)
+
+	_, ok = WknFromIsin("US0378331005")
+	a.False(ok)
+}
+
+func TestCusipFromIsin(t *testing.T) {
+	a := assert.New(t)
+
+	cusip, ok := CusipFromIsin("US0378331005")
+	a.True(ok)
+	a.Equal("037833100", cusip)
+
+	cusip, ok = CusipFromIsin("US38259P5089")
+	a.True(ok)
+	a.Equal("38259P508", cusip)
+
+	_, ok = CusipFromIsin("DE0007164600")
+	a.False(ok)
+}
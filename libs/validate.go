@@ -21,11 +21,26 @@ func isValidUsername(fl validator.FieldLevel) bool {
 	return ValidUserNameRegex.MatchString(fl.Field().String())
 }
 
+func isIsin(fl validator.FieldLevel) bool {
+	return IsValidIsin(fl.Field().String())
+}
+
+func isWkn(fl validator.FieldLevel) bool {
+	return IsValidWkn(fl.Field().String())
+}
+
+func isCusip(fl validator.FieldLevel) bool {
+	return IsValidCusip(fl.Field().String())
+}
+
 // RegisterCustomValidations registers custom validators
 func RegisterCustomValidations(v *validator.Validate) {
 	v.RegisterValidation("DateYYYY-MM-DD", isDateYYYYMMDD)
 	v.RegisterValidation("LaxUuid", isLaxUuid)
 	v.RegisterValidation("ValidUsername", isValidUsername)
+	v.RegisterValidation("Isin", isIsin)
+	v.RegisterValidation("Wkn", isWkn)
+	v.RegisterValidation("Cusip", isCusip)
 }
 
 // GetValidator creates and returns validator with custom validations
@@ -78,3 +78,75 @@ func TestIsValidUsername(t *testing.T) {
 		})
 	}
 }
+
+func TestIsIsin(t *testing.T) {
+	val := GetValidator()
+
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{"US0378331005", true},
+		{"DE0007164600", true},
+		{"DE000BASF111", true},
+		{"IE00B4L5Y983", true},
+		{"US0378331006", false},
+		{"ZZ0378331005", false},
+		{"us0378331005", false},
+		{"US037833100", false},
+		{"US03783310055", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			err := val.Var(tc.input, "Isin")
+			assert.Equal(t, err == nil, tc.valid)
+		})
+	}
+}
+
+func TestIsWkn(t *testing.T) {
+	val := GetValidator()
+
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{"716460", true},
+		{"BASF11", true},
+		{"A0F5UF", true},
+		{"71646", false},
+		{"7164600", false},
+		{"71646O", false},
+		{"a0f5uf", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			err := val.Var(tc.input, "Wkn")
+			assert.Equal(t, err == nil, tc.valid)
+		})
+	}
+}
+
+func TestIsCusip(t *testing.T) {
+	val := GetValidator()
+
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{"037833100", true},
+		{"38259P508", true},
+		{"037833101", false},
+		{"03783310", false},
+		{"38259p508", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			err := val.Var(tc.input, "Cusip")
+			assert.Equal(t, err == nil, tc.valid)
+		})
+	}
+}
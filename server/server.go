@@ -21,31 +21,53 @@ import (
 	"github.com/gin-gonic/gin/binding"
 )
 
-func setupCron(cs model.CurrenciesService) {
-	logger := log.New(os.Stderr, "[cron] ", log.LstdFlags|log.Lmsgprefix)
-
-	updateExchangeRates := func() {
-		// Recover from panic
-		defer func() {
-			if r := recover(); r != nil {
-				logger.Println("Panic while updating exchange rates:", r,
-					"\nstacktrace:\n"+string(debug.Stack()))
-			}
-		}()
-
-		if err := cs.UpdateExchangeRates(); err != nil {
-			logger.Println("Error while updating exchange rates:", err)
+// runCronJob runs job, logs errors and recovers from panics
+func runCronJob(logger *log.Logger, name string, job func() error) {
+	// Recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Println("Panic while "+name+":", r,
+				"\nstacktrace:\n"+string(debug.Stack()))
 		}
+	}()
+
+	if err := job(); err != nil {
+		logger.Println("Error while "+name+":", err)
 	}
+}
+
+func setupCron(cs model.CurrenciesService, ps model.PriceService) {
+	logger := log.New(os.Stderr, "[cron] ", log.LstdFlags|log.Lmsgprefix)
 
 	go func() {
 		// Run once after 5min, then every 2hours
 		time.Sleep(5 * time.Minute)
 		for {
-			updateExchangeRates()
+			runCronJob(logger, "updating exchange rates", cs.UpdateExchangeRates)
 			time.Sleep(2 * time.Hour)
 		}
 	}()
+
+	go func() {
+		// Run once after 7min, then every hour
+		time.Sleep(7 * time.Minute)
+		for {
+			runCronJob(logger, "updating security prices", ps.UpdatePrices)
+			time.Sleep(1 * time.Hour)
+		}
+	}()
+
+	go func() {
+		// Run once after 30min, then every 6hours
+		time.Sleep(30 * time.Minute)
+		for {
+			runCronJob(logger, "checking quality of security prices", func() error {
+				_, err := ps.CheckPriceQuality(nil)
+				return err
+			})
+			time.Sleep(6 * time.Hour)
+		}
+	}()
 }
 
 func PrepareApp() (*service.Config, *gorm.DB) {
@@ -75,23 +97,48 @@ func InitializeService(cfg *service.Config, db *gorm.DB) *handler.Config {
 	geoipService := service.NewGeoipService(cfg.Ip2locToken)
 	userService := service.NewUserService(db)
 	sessionService := service.NewSessionService(db, validate, cfg.SessionTimeout)
-	portfolioService := service.NewPortfolioService(db)
-	securityService := service.NewSecurityService(cfg, db)
-	taxonomyService := service.NewTaxonomyService(db, validate)
+	portfolioService := service.NewPortfolioService(db, currenciesService)
+	logoStore, err := service.NewLogoStore(cfg)
+	if err != nil {
+		fmt.Println("WARNING: Cannot upload logos, could not create logo store: " + err.Error())
+	}
+	cacheService := service.NewCacheService(cfg.CacheMaxAge)
+	securityService := service.NewSecurityService(db, validate, logoStore, service.LogoBaseURL(cfg), cacheService)
+	marketService := service.NewMarketService(db)
+	eventService := service.NewEventService(db, securityService, currenciesService)
 	mailerService, err := service.NewMailerService(cfg.MailerTransport, cfg.ContactRecipientEmail, validate)
 	if err != nil {
 		fmt.Println("WARNING: Cannot send emails, could not create MailerService: " + err.Error())
 	}
+	alertService := service.NewAlertService(db, mailerService)
+	priceService := service.NewPriceService(db, currenciesService, alertService)
+	if cfg.PricesFileDir != "" {
+		fileProvider := service.NewFilePriceProvider(cfg.PricesFileDir)
+		for _, marketCode := range cfg.PricesFileMarkets {
+			priceService.RegisterPriceProvider(marketCode, fileProvider)
+		}
+	}
+	taxonomyService := service.NewTaxonomyService(db, validate)
 
 	// Setup cronjobs
-	setupCron(currenciesService)
+	setupCron(currenciesService, priceService)
 
 	// Register custom validations on GIN validator
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		libs.RegisterCustomValidations(v)
 	}
 
+	// Logos in local directory are served by API, also if no logos can be uploaded
+	logoDir := ""
+	if cfg.LogoStore == "file" {
+		logoDir = cfg.LogoDir
+	}
+
 	return &handler.Config{
+		AlertService:      alertService,
+		EventService:      eventService,
+		MarketService:     marketService,
+		PriceService:      priceService,
 		UserService:       userService,
 		SessionService:    sessionService,
 		CurrenciesService: currenciesService,
@@ -100,8 +147,10 @@ func InitializeService(cfg *service.Config, db *gorm.DB) *handler.Config {
 		PortfolioService:  portfolioService,
 		SecurityService:   securityService,
 		TaxonomyService:   taxonomyService,
+		CacheService:      cacheService,
 		BaseURL:           "",
-		CacheMaxAge:       cfg.CacheMaxAge,
+		SearchMaxResults:  cfg.SearchMaxResults,
+		LogoDir:           logoDir,
 		DB:                db,
 		Validate:          validate,
 	}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type alertService struct {
+	DB            *gorm.DB
+	MailerService model.MailerService
+}
+
+// NewAlertService creates and returns new alert service
+func NewAlertService(db *gorm.DB, mailerService model.MailerService) model.AlertService {
+	return &alertService{
+		DB:            db,
+		MailerService: mailerService,
+	}
+}
+
+// modelFromDb converts alert from database into model
+func (*alertService) modelFromDb(a db.Alert) *model.Alert {
+	var triggeredAt *time.Time
+	if a.TriggeredAt != nil {
+		t := a.TriggeredAt.UTC()
+		triggeredAt = &t
+	}
+
+	return &model.Alert{
+		ID:           int(a.ID),
+		SecurityUUID: a.SecurityUUID,
+		MarketCode:   a.MarketCode,
+		Type:         a.Type,
+		Threshold:    a.Threshold,
+		CreatedAt:    a.CreatedAt.UTC(),
+		TriggeredAt:  triggeredAt,
+
+		NotificationError: a.NotificationError,
+	}
+}
+
+// GetAlertsOfUser returns all alerts of user
+func (s *alertService) GetAlertsOfUser(user *model.User) []*model.Alert {
+	var alerts []db.Alert
+	if err := s.DB.Order("id").Find(&alerts, "user_id = ?", user.ID).Error; err != nil {
+		panic(err)
+	}
+
+	response := make([]*model.Alert, len(alerts))
+	for i := range alerts {
+		response[i] = s.modelFromDb(alerts[i])
+	}
+	return response
+}
+
+// CreateAlert creates new alert for user, notifications are sent to
+// the verified email address of user
+func (s *alertService) CreateAlert(user *model.User, input *model.AlertInput) (*model.Alert, error) {
+	if user.Email == nil || !user.EmailVerified {
+		return nil, errors.New("verified email address of user is required")
+	}
+
+	alert := db.Alert{
+		UserID:       uint(user.ID),
+		SecurityUUID: input.SecurityUUID,
+		Type:         input.Type,
+	}
+
+	switch input.Type {
+	case model.AlertTypePriceAbove, model.AlertTypePriceBelow, model.AlertTypePercentChange:
+		if input.MarketCode == nil {
+			return nil, fmt.Errorf("marketCode is missing")
+		}
+		if input.Threshold == nil {
+			return nil, fmt.Errorf("threshold is missing")
+		}
+		if !input.Threshold.IsPositive() {
+			return nil, fmt.Errorf("threshold must be positive")
+		}
+
+		var count int64
+		if err := s.DB.Model(&db.SecurityMarket{}).
+			Where("security_uuid = ? AND market_code = ?", input.SecurityUUID, *input.MarketCode).
+			Count(&count).Error; err != nil {
+			panic(err)
+		}
+		if count == 0 {
+			return nil, fmt.Errorf("market %s not found for security", *input.MarketCode)
+		}
+
+		alert.MarketCode = input.MarketCode
+		alert.Threshold = input.Threshold
+	case model.AlertTypeDividend:
+		// Only dividends added after creation of alert shall trigger it
+		if err := s.DB.Model(&db.Event{}).
+			Select("COALESCE(MAX(id), 0)").
+			Where("security_uuid = ?", input.SecurityUUID).
+			Scan(&alert.LastEventID).Error; err != nil {
+			panic(err)
+		}
+	default:
+		panic("invalid type: " + input.Type.String())
+	}
+
+	if err := s.DB.Clauses(clause.Returning{}).Create(&alert).Error; err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return nil, fmt.Errorf("data violates constraint %s", pqErr.Constraint)
+		}
+
+		panic(err)
+	}
+
+	return s.modelFromDb(alert), nil
+}
+
+// DeleteAlert removes alert of user
+func (s *alertService) DeleteAlert(user *model.User, ID uint) (*model.Alert, error) {
+	var alert db.Alert
+	result := s.DB.
+		Clauses(clause.Returning{}).
+		Where("user_id = ? AND id = ?", user.ID, ID).
+		Delete(&alert)
+	if err := result.Error; err != nil {
+		panic(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+	return s.modelFromDb(alert), nil
+}
+
+// alertNotification holds data to render notification mail of triggered alert
+type alertNotification struct {
+	SecurityName string
+	MarketCode   string
+	Type         string
+	Threshold    string
+	Date         string
+	Value        string
+	CurrencyCode string
+}
+
+// EvaluateAlerts checks all pending alerts against latest prices and events,
+// sends notifications to their users and marks triggered alerts, so each alert
+// fires once. Failed notifications are recorded with alert and retried next run.
+func (s *alertService) EvaluateAlerts() error {
+	if s.MailerService == nil {
+		return errors.New("cannot send notifications, mailer not configured")
+	}
+
+	log.Println("Evaluating alerts...")
+
+	var alerts []db.Alert
+	if err := s.DB.Preload("Security").Preload("User").Find(&alerts, "triggered_at IS NULL").Error; err != nil {
+		panic(err)
+	}
+
+	count, failed := 0, 0
+	for _, alert := range alerts {
+		notification := s.checkAlert(alert)
+		if notification == nil {
+			continue
+		}
+
+		// Claim alert before sending, so concurrent runs cannot notify twice
+		result := s.DB.Model(&db.Alert{}).
+			Where("id = ? AND triggered_at IS NULL", alert.ID).
+			Updates(map[string]interface{}{"triggered_at": time.Now(), "notification_error": nil})
+		if err := result.Error; err != nil {
+			panic(err)
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		var err error
+		if alert.User.Email == nil || !alert.User.EmailVerified {
+			err = errors.New("user has no verified email address")
+		} else {
+			err = s.MailerService.SendMail(*alert.User.Email, "Alert on "+notification.SecurityName, "alert.txt", notification)
+		}
+		if err != nil {
+			log.Printf("Sending notification of alert %d failed: %v\n", alert.ID, err)
+			failed++
+
+			// Release alert to retry with next run
+			if err := s.DB.Model(&db.Alert{}).
+				Where("id = ?", alert.ID).
+				Updates(map[string]interface{}{"triggered_at": nil, "notification_error": err.Error()}).Error; err != nil {
+				panic(err)
+			}
+			continue
+		}
+
+		count++
+	}
+
+	log.Printf("Evaluating alerts finished, %d alert(s) triggered, %d failed.\n", count, failed)
+	if failed > 0 {
+		return fmt.Errorf("sending notifications of %d alert(s) failed", failed)
+	}
+	return nil
+}
+
+// checkAlert returns notification if condition of alert is met, nil otherwise
+func (s *alertService) checkAlert(alert db.Alert) *alertNotification {
+	notification := &alertNotification{
+		SecurityName: alert.SecurityUUID.String(),
+		Type:         alert.Type.String(),
+	}
+	if alert.Security.Name != nil {
+		notification.SecurityName = *alert.Security.Name
+	}
+	if alert.MarketCode != nil {
+		notification.MarketCode = *alert.MarketCode
+	}
+	if alert.Threshold != nil {
+		notification.Threshold = alert.Threshold.String()
+	}
+
+	if alert.Type == model.AlertTypeDividend {
+		var event db.Event
+		result := s.DB.
+			Where("security_uuid = ? AND type = 'dividend' AND id > ?", alert.SecurityUUID, alert.LastEventID).
+			Order("id").Limit(1).
+			Find(&event)
+		if err := result.Error; err != nil {
+			panic(err)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		notification.Date = event.Date.String()
+		if event.Amount != nil {
+			notification.Value = *event.Amount
+		}
+		if event.CurrencyCode != nil {
+			notification.CurrencyCode = *event.CurrencyCode
+		}
+		return notification
+	}
+
+	var market db.SecurityMarket
+	if err := s.DB.Take(&market, "security_uuid = ? AND market_code = ?", alert.SecurityUUID, *alert.MarketCode).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		panic(err)
+	}
+	notification.CurrencyCode = market.CurrencyCode
+
+	var prices []struct {
+		Date  model.Date
+		Close decimal.Decimal
+	}
+	if err := s.DB.Model(&db.SecurityMarketPrice{}).
+		Select("date", "close").
+		Where("security_market_id = ?", market.ID).
+		Order("date DESC").Limit(2).
+		Find(&prices).Error; err != nil {
+		panic(err)
+	}
+
+	// Only prices arriving after creation of alert are considered
+	createdAt := model.Date{}.FromTime(alert.CreatedAt)
+	if len(prices) == 0 || prices[0].Date.Time().Before(createdAt.Time()) {
+		return nil
+	}
+	latest := prices[0]
+	notification.Date = latest.Date.String()
+	notification.Value = latest.Close.String()
+
+	switch alert.Type {
+	case model.AlertTypePriceAbove:
+		if latest.Close.GreaterThan(*alert.Threshold) {
+			return notification
+		}
+	case model.AlertTypePriceBelow:
+		if latest.Close.LessThan(*alert.Threshold) {
+			return notification
+		}
+	case model.AlertTypePercentChange:
+		if len(prices) < 2 || prices[1].Close.IsZero() {
+			return nil
+		}
+		change := latest.Close.Sub(prices[1].Close).Div(prices[1].Close).Mul(decimal.NewFromInt(100))
+		if change.Abs().GreaterThanOrEqual(*alert.Threshold) {
+			notification.Value = change.StringFixed(2)
+			return notification
+		}
+	}
+
+	return nil
+}
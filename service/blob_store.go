@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/portfolio-report/pr-api/graph/model"
+)
+
+// NewLogoStore creates blob store for logos of securities as selected by config
+func NewLogoStore(c *Config) (model.BlobStore, error) {
+	switch c.LogoStore {
+	case "s3":
+		return NewS3BlobStore(c.AwsAccessKeyID, c.AwsSecretAccessKey, c.AwsRegion,
+			c.AwsLogoBucket, c.AwsLogoBucketURL)
+	case "file":
+		return NewFileBlobStore(c.LogoDir, c.LogoURL)
+	default:
+		return nil, fmt.Errorf("unknown logo store %s", c.LogoStore)
+	}
+}
+
+// LogoBaseURL returns URL prefix of logos in store selected by config,
+// which is known even if store cannot be created
+func LogoBaseURL(c *Config) string {
+	switch c.LogoStore {
+	case "s3":
+		return c.AwsLogoBucketURL
+	case "file":
+		return c.LogoURL
+	default:
+		return ""
+	}
+}
+
+type s3BlobStore struct {
+	client    *s3.Client
+	bucket    string
+	bucketURL string
+}
+
+// NewS3BlobStore creates blob store saving files in S3 bucket,
+// URLs are built by prefixing keys with bucketURL
+func NewS3BlobStore(accessKeyID, secretAccessKey, region, bucket, bucketURL string) (model.BlobStore, error) {
+	if bucket == "" {
+		return nil, errors.New("no bucket configured")
+	}
+
+	creds := credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, "")
+	cfg, err := config.LoadDefaultConfig(context.TODO(), config.WithCredentialsProvider(creds), config.WithRegion(region))
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3BlobStore{
+		client:    s3.NewFromConfig(cfg),
+		bucket:    bucket,
+		bucketURL: bucketURL,
+	}, nil
+}
+
+// Put uploads file with metadata to bucket
+func (s *s3BlobStore) Put(ctx context.Context, key string, r io.Reader, meta model.BlobMeta) error {
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   r,
+	}
+	if meta.ContentType != "" {
+		input.ContentType = aws.String(meta.ContentType)
+	}
+	if meta.CacheControl != "" {
+		input.CacheControl = aws.String(meta.CacheControl)
+	}
+	_, err := s.client.PutObject(ctx, input)
+	return err
+}
+
+// URL returns absolute URL of file in bucket
+func (s *s3BlobStore) URL(key string) string {
+	return s.bucketURL + key
+}
+
+type fileBlobStore struct {
+	dir     string
+	baseURL string
+}
+
+// NewFileBlobStore creates blob store saving files in local directory,
+// which is served by API at baseURL, e.g. for development and testing
+func NewFileBlobStore(dir, baseURL string) (model.BlobStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &fileBlobStore{dir: dir, baseURL: baseURL}, nil
+}
+
+// Put writes file to directory, existing files are replaced atomically.
+// Metadata is not stored, content type is derived from extension of key when served.
+func (s *fileBlobStore) Put(ctx context.Context, key string, r io.Reader, meta model.BlobMeta) error {
+	if key != filepath.Base(key) || key == "." || key == ".." {
+		return fmt.Errorf("invalid key %s", key)
+	}
+
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(s.dir, key))
+}
+
+// URL returns URL of file served by API
+func (s *fileBlobStore) URL(key string) string {
+	return s.baseURL + key
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileBlobStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "logos")
+
+	store, err := NewFileBlobStore(dir, "/logos/")
+	require.Nil(t, err)
+	assert.Equal(t, "/logos/logo.png", store.URL("logo.png"))
+
+	ctx := context.Background()
+	require.Nil(t, store.Put(ctx, "logo.png", strings.NewReader("first"), model.BlobMeta{ContentType: "image/png"}))
+	require.Nil(t, store.Put(ctx, "logo.png", strings.NewReader("second"), model.BlobMeta{ContentType: "image/png"}))
+	content, err := os.ReadFile(filepath.Join(dir, "logo.png"))
+	require.Nil(t, err)
+	assert.Equal(t, "second", string(content))
+
+	for _, key := range []string{"../logo.png", "sub/logo.png", ".."} {
+		assert.NotNil(t, store.Put(ctx, key, strings.NewReader("x"), model.BlobMeta{ContentType: "image/png"}), key)
+	}
+
+	// No temporary files are left behind
+	entries, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestNewLogoStore(t *testing.T) {
+	store, err := NewLogoStore(&Config{LogoStore: "file", LogoDir: t.TempDir(), LogoURL: "/logos/"})
+	assert.Nil(t, err)
+	assert.NotNil(t, store)
+
+	_, err = NewLogoStore(&Config{LogoStore: "s3"})
+	assert.NotNil(t, err)
+
+	_, err = NewLogoStore(&Config{LogoStore: "ftp"})
+	assert.NotNil(t, err)
+}
+
+func TestLogoBaseURL(t *testing.T) {
+	assert.Equal(t, "/logos/", LogoBaseURL(&Config{LogoStore: "file", LogoURL: "/logos/"}))
+	assert.Equal(t, "https://bucket/", LogoBaseURL(&Config{LogoStore: "s3", AwsLogoBucketURL: "https://bucket/"}))
+	assert.Equal(t, "", LogoBaseURL(&Config{LogoStore: "ftp"}))
+}
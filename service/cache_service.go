@@ -0,0 +1,106 @@
+package service
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cache "github.com/chenyahui/gin-cache"
+	"github.com/chenyahui/gin-cache/persist"
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/graph/model"
+)
+
+type cacheService struct {
+	store  *persist.MemoryStore
+	maxAge time.Duration
+
+	// Generations are part of cache keys, incrementing them invalidates entries,
+	// which remain in store until they expire
+	mu             sync.Mutex
+	tagGenerations map[string]uint64
+}
+
+// NewCacheService creates in-memory cache of responses, responses are
+// not cached if maxAge is 0
+func NewCacheService(maxAge time.Duration) model.CacheService {
+	return &cacheService{
+		store:          persist.NewMemoryStore(maxAge),
+		maxAge:         maxAge,
+		tagGenerations: map[string]uint64{},
+	}
+}
+
+// Middleware caches successful responses by request URI, tags returns tags
+// of request, e.g. UUIDs of securities contained in response. Responses being
+// generated while their tags are invalidated are stored for outdated keys only.
+func (s *cacheService) Middleware(tags func(c *gin.Context) []string) gin.HandlerFunc {
+	if s.maxAge == 0 {
+		return func(c *gin.Context) {}
+	}
+
+	return cache.Cache(s.store, s.maxAge,
+		cache.WithCacheStrategyByRequest(func(c *gin.Context) (bool, cache.Strategy) {
+			return true, cache.Strategy{CacheKey: s.key(c.Request.RequestURI, tags(c))}
+		}))
+}
+
+// Fetch returns JSON body of cached response of request URI with tags or
+// caches body returned by load. Entries are shared with Middleware, so that
+// responses cached by either are used by both.
+func (s *cacheService) Fetch(uri string, tags []string, load func() ([]byte, error)) ([]byte, error) {
+	if s.maxAge == 0 {
+		return load()
+	}
+
+	key := s.key(uri, tags)
+	respCache := &cache.ResponseCache{}
+	if err := s.store.Get(key, &respCache); err == nil && respCache.Status == http.StatusOK {
+		return respCache.Data, nil
+	}
+
+	data, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	respCache = &cache.ResponseCache{Status: http.StatusOK, Header: header, Data: data}
+	if err := s.store.Set(key, respCache, s.maxAge); err != nil {
+		panic(err)
+	}
+	return data, nil
+}
+
+// key returns cache key of request URI for current generations of tags
+func (s *cacheService) key(uri string, tags []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sort.Strings(tags)
+	var key strings.Builder
+	for _, tag := range tags {
+		key.WriteString(tag + "#" + strconv.FormatUint(s.tagGenerations[tag], 10) + "|")
+	}
+	key.WriteString(uri)
+	return key.String()
+}
+
+// InvalidateTags invalidates all cached responses with any of tags
+func (s *cacheService) InvalidateTags(tags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range tags {
+		s.tagGenerations[tag]++
+	}
+}
+
+// Purge removes all cached responses
+func (s *cacheService) Purge() {
+	s.store.Cache.Purge()
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheService(t *testing.T) {
+	a := assert.New(t)
+
+	calls := 0
+	router := gin.New()
+	cacheService := NewCacheService(time.Minute)
+	router.GET("/:id", cacheService.Middleware(func(c *gin.Context) []string {
+		return []string{"id:" + c.Param("id")}
+	}), func(c *gin.Context) {
+		calls++
+		c.String(200, strconv.Itoa(calls))
+	})
+
+	get := func(target string) string {
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, httptest.NewRequest("GET", target, nil))
+		return res.Body.String()
+	}
+
+	a.Equal("1", get("/a"))
+	a.Equal("2", get("/b"))
+	a.Equal("1", get("/a"))
+	a.Equal("3", get("/a?x=1"))
+
+	// Only responses with tag are invalidated
+	cacheService.InvalidateTags("id:a")
+	a.Equal("4", get("/a"))
+	a.Equal("5", get("/a?x=1"))
+	a.Equal("2", get("/b"))
+	a.Equal("4", get("/a"))
+
+	cacheService.Purge()
+	a.Equal("6", get("/b"))
+
+	// Caching is disabled without maxAge
+	router = gin.New()
+	router.GET("/", NewCacheService(0).Middleware(func(c *gin.Context) []string { return nil }),
+		func(c *gin.Context) {
+			calls++
+			c.String(200, strconv.Itoa(calls))
+		})
+	a.Equal("7", get("/"))
+	a.Equal("8", get("/"))
+}
+
+func TestCacheServiceFetch(t *testing.T) {
+	a := assert.New(t)
+
+	calls := 0
+	router := gin.New()
+	cacheService := NewCacheService(time.Minute)
+	tags := func(c *gin.Context) []string { return []string{"id:" + c.Param("id")} }
+	router.GET("/:id", cacheService.Middleware(tags), func(c *gin.Context) {
+		calls++
+		c.JSON(200, gin.H{"calls": calls})
+	})
+	load := func() ([]byte, error) {
+		calls++
+		return []byte(`{"calls":` + strconv.Itoa(calls) + `}`), nil
+	}
+
+	get := func(target string) (string, string) {
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, httptest.NewRequest("GET", target, nil))
+		return res.Body.String(), res.Header().Get("Content-Type")
+	}
+
+	// Entries are shared between middleware and fetch
+	body, _ := get("/a")
+	a.Equal(`{"calls":1}`, body)
+	data, err := cacheService.Fetch("/a", []string{"id:a"}, load)
+	a.Nil(err)
+	a.Equal(`{"calls":1}`, string(data))
+
+	data, err = cacheService.Fetch("/b", []string{"id:b"}, load)
+	a.Nil(err)
+	a.Equal(`{"calls":2}`, string(data))
+	body, contentType := get("/b")
+	a.Equal(`{"calls":2}`, body)
+	a.Equal("application/json; charset=utf-8", contentType)
+
+	cacheService.InvalidateTags("id:b")
+	data, err = cacheService.Fetch("/b", []string{"id:b"}, load)
+	a.Nil(err)
+	a.Equal(`{"calls":3}`, string(data))
+
+	// Errors are not cached
+	_, err = cacheService.Fetch("/c", []string{"id:c"}, func() ([]byte, error) {
+		return nil, errors.New("failed")
+	})
+	a.EqualError(err, "failed")
+	data, err = cacheService.Fetch("/c", []string{"id:c"}, load)
+	a.Nil(err)
+	a.Equal(`{"calls":4}`, string(data))
+
+	// Caching is disabled without maxAge
+	data, err = NewCacheService(0).Fetch("/a", nil, load)
+	a.Nil(err)
+	a.Equal(`{"calls":5}`, string(data))
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/portfolio-report/pr-api/db"
@@ -23,6 +24,11 @@ type Config struct {
 	AwsRegion             string
 	AwsLogoBucket         string
 	AwsLogoBucketURL      string
+	LogoStore             string
+	LogoDir               string
+	LogoURL               string
+	PricesFileDir         string
+	PricesFileMarkets     []string
 }
 
 func defaultAtoi(s string, def int) int {
@@ -33,6 +39,13 @@ func defaultAtoi(s string, def int) int {
 	return v
 }
 
+func defaultString(s string, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
 // ReadConfig reads and parses configuration from environment variables.
 // It also sets default values where applicable.
 func ReadConfig() *Config {
@@ -68,5 +81,23 @@ func ReadConfig() *Config {
 	c.AwsLogoBucket = os.Getenv("AWS_LOGO_BUCKET")
 	c.AwsLogoBucketURL = os.Getenv("AWS_LOGO_BUCKET_URL")
 
+	// Store logos in S3 if bucket is configured, otherwise in local directory
+	c.LogoStore = os.Getenv("LOGO_STORE")
+	if c.LogoStore == "" {
+		c.LogoStore = "file"
+		if c.AwsLogoBucket != "" {
+			c.LogoStore = "s3"
+		}
+	}
+	c.LogoDir = defaultString(os.Getenv("LOGO_DIR"), "./logos")
+	c.LogoURL = defaultString(os.Getenv("LOGO_URL"), "/logos/")
+
+	c.PricesFileDir = os.Getenv("PRICES_FILE_DIR")
+	for _, m := range strings.Split(os.Getenv("PRICES_FILE_MARKETS"), ",") {
+		if m = strings.TrimSpace(m); m != "" {
+			c.PricesFileMarkets = append(c.PricesFileMarkets, m)
+		}
+	}
+
 	return &c
 }
@@ -100,6 +100,21 @@ func (s *currenciesService) GetCurrencies() []*model.Currency {
 	return response
 }
 
+// GetCurrenciesLastModified returns latest update of currencies and exchange rates
+// and their total number, which changes if any of them is removed
+func (s *currenciesService) GetCurrenciesLastModified() (time.Time, int64) {
+	var result struct {
+		UpdatedAt time.Time
+		Count     int64
+	}
+	if err := s.DB.Raw(`SELECT COALESCE(MAX(updated_at), to_timestamp(0)) AS updated_at, COUNT(*) AS count FROM (` +
+		`SELECT updated_at FROM currencies UNION ALL SELECT updated_at FROM exchangerates` +
+		`) t`).Scan(&result).Error; err != nil {
+		panic(err)
+	}
+	return result.UpdatedAt, result.Count
+}
+
 // GetExchangerate returns exchange rate identified by base and quote currency code
 func (s *currenciesService) GetExchangerate(baseCC, quoteCC string) (*model.Exchangerate, error) {
 	var er db.Exchangerate
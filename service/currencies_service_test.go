@@ -207,3 +207,10 @@ func (s *CurrenciesServiceTestSuite) TestConvertCurrencyAmount() {
 
 	}
 }
+
+func (s *CurrenciesServiceTestSuite) TestGetCurrenciesLastModified() {
+	lastModified, count := s.service.GetCurrenciesLastModified()
+	s.False(lastModified.IsZero())
+	s.False(lastModified.After(time.Now()))
+	s.GreaterOrEqual(count, int64(35))
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxEventAmount is the exclusive upper limit of amounts, see events.amount DECIMAL(10,4)
+var maxEventAmount = decimal.NewFromInt(1000000)
+
+const (
+	// defaultEventCalendarLimit is number of events in calendar without limit
+	defaultEventCalendarLimit = 100
+	// maxEventCalendarLimit limits number of events in calendar
+	maxEventCalendarLimit = 1000
+)
+
+type eventService struct {
+	DB                *gorm.DB
+	SecurityService   model.SecurityService
+	CurrenciesService model.CurrenciesService
+}
+
+// NewEventService creates and returns new event service
+func NewEventService(
+	db *gorm.DB,
+	securityService model.SecurityService,
+	currenciesService model.CurrenciesService,
+) model.EventService {
+	return &eventService{
+		DB:                db,
+		SecurityService:   securityService,
+		CurrenciesService: currenciesService,
+	}
+}
+
+// modelFromDb converts event from database into model
+func (*eventService) modelFromDb(e db.Event) *model.SecurityEvent {
+	return &model.SecurityEvent{
+		ID:           e.ID,
+		SecurityUUID: uuid.MustParse(e.SecurityUuid),
+		Date:         e.Date,
+		Type:         e.Type,
+		Amount:       e.Amount,
+		CurrencyCode: e.CurrencyCode,
+		Ratio:        e.Ratio,
+	}
+}
+
+// GetSecurityEvents returns all events of security sorted by date
+func (s *eventService) GetSecurityEvents(securityUuid uuid.UUID) ([]*model.SecurityEvent, error) {
+	if err := s.checkSecurity(s.DB, securityUuid); err != nil {
+		return nil, err
+	}
+
+	var events []db.Event
+	if err := s.DB.Order("date, id").Find(&events, "security_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+
+	ret := make([]*model.SecurityEvent, len(events))
+	for i := range events {
+		ret[i] = s.modelFromDb(events[i])
+	}
+	return ret, nil
+}
+
+// CreateSecurityEvent creates new event of security
+func (s *eventService) CreateSecurityEvent(securityUuid uuid.UUID, input *model.SecurityEventInput) (*model.SecurityEvent, error) {
+	if err := s.checkSecurity(s.DB, securityUuid); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventFromInput(s.DB, securityUuid, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.Clauses(clause.Returning{}).Create(&event).Error; err != nil {
+		panic(err)
+	}
+	touchSecurity(s.DB, securityUuid)
+
+	return s.modelFromDb(event), nil
+}
+
+// UpdateSecurityEvent updates event of security
+func (s *eventService) UpdateSecurityEvent(securityUuid uuid.UUID, id uint, input *model.SecurityEventInput) (*model.SecurityEvent, error) {
+	event, err := s.eventFromInput(s.DB, securityUuid, input)
+	if err != nil {
+		return nil, err
+	}
+	event.ID = id
+
+	result := s.DB.
+		Model(&db.Event{}).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND security_uuid = ?", id, securityUuid).
+		Select("*").
+		Updates(&event)
+	if err := result.Error; err != nil {
+		panic(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+	touchSecurity(s.DB, securityUuid)
+
+	return s.modelFromDb(event), nil
+}
+
+// DeleteSecurityEvent removes event of security
+func (s *eventService) DeleteSecurityEvent(securityUuid uuid.UUID, id uint) (*model.SecurityEvent, error) {
+	var event db.Event
+	result := s.DB.
+		Clauses(clause.Returning{}).
+		Delete(&event, "id = ? AND security_uuid = ?", id, securityUuid)
+	if err := result.Error; err != nil {
+		panic(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+	touchSecurity(s.DB, securityUuid)
+
+	return s.modelFromDb(event), nil
+}
+
+// UpsertSecurityEvents creates or updates events of security identified by
+// date and type, e.g. for imports from data vendors. Other events are kept.
+func (s *eventService) UpsertSecurityEvents(securityUuid uuid.UUID, inputs []*model.SecurityEventInput) ([]*model.SecurityEvent, error) {
+	var ret []*model.SecurityEvent
+
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.checkSecurity(tx, securityUuid); err != nil {
+			return err
+		}
+
+		var existing []db.Event
+		if err := tx.Order("id").Find(&existing, "security_uuid = ?", securityUuid).Error; err != nil {
+			panic(err)
+		}
+		existingByKey := map[string]*db.Event{}
+		for i := range existing {
+			key := existing[i].Date.String() + "/" + existing[i].Type
+			if existingByKey[key] != nil {
+				return fmt.Errorf("multiple events of type %s at %s exist", existing[i].Type, existing[i].Date)
+			}
+			existingByKey[key] = &existing[i]
+		}
+
+		seen := map[string]bool{}
+		ret = make([]*model.SecurityEvent, len(inputs))
+		for i, input := range inputs {
+			event, err := s.eventFromInput(tx, securityUuid, input)
+			if err != nil {
+				return fmt.Errorf("event %d: %w", i, err)
+			}
+
+			key := event.Date.String() + "/" + event.Type
+			if seen[key] {
+				return fmt.Errorf("event %d: duplicate event of type %s at %s", i, event.Type, event.Date)
+			}
+			seen[key] = true
+
+			if e, ok := existingByKey[key]; ok {
+				event.ID = e.ID
+				if err := tx.Clauses(clause.Returning{}).Select("*").Updates(&event).Error; err != nil {
+					panic(err)
+				}
+			} else {
+				if err := tx.Clauses(clause.Returning{}).Create(&event).Error; err != nil {
+					panic(err)
+				}
+			}
+			ret[i] = s.modelFromDb(event)
+		}
+		touchSecurity(tx, securityUuid)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// GetEventCalendar lists events across all securities sorted by date. With
+// portfolio only events of securities held in portfolio are listed, incl.
+// shares and expected income of dividends in base currency of portfolio.
+// Limit of query defaults to 100 and is capped at 1000, query is updated accordingly.
+func (s *eventService) GetEventCalendar(query *model.EventCalendarQuery, portfolio *model.Portfolio) *model.EventCalendar {
+	if query.Limit <= 0 {
+		query.Limit = defaultEventCalendarLimit
+	} else if query.Limit > maxEventCalendarLimit {
+		query.Limit = maxEventCalendarLimit
+	}
+	if query.Skip < 0 {
+		query.Skip = 0
+	}
+
+	q := s.DB.Table("events e").
+		Joins("INNER JOIN securities s ON s.uuid = e.security_uuid")
+
+	if query.From != nil {
+		q = q.Where("e.date >= ?", *query.From)
+	}
+	if query.To != nil {
+		q = q.Where("e.date <= ?", *query.To)
+	}
+	if query.Type != nil {
+		q = q.Where("e.type = ?", *query.Type)
+	}
+	if query.SecurityType != nil {
+		q = q.Where("s.security_type = ?", *query.SecurityType)
+	}
+	if query.Tag != nil {
+		q = q.Where(`EXISTS (SELECT 1 FROM securities_tags st INNER JOIN tags t ON t.uuid = st.tag_uuid `+
+			`WHERE st.security_uuid = s.uuid AND t.name = ?)`, *query.Tag)
+	}
+
+	selects := "e.*, s.name AS security_name, s.isin, s.extras"
+	if portfolio != nil {
+		// Current holdings of portfolio by security of master data
+		q = q.Joins(`INNER JOIN (`+
+			`SELECT ps.security_uuid, SUM(t.shares) AS shares `+
+			`FROM portfolios_securities ps INNER JOIN portfolios_transactions t `+
+			`ON t.portfolio_id = ps.portfolio_id AND t.portfolio_security_uuid = ps.uuid `+
+			`WHERE ps.portfolio_id = ? AND ps.security_uuid IS NOT NULL `+
+			`AND t.type IN ('SecuritiesOrder', 'SecuritiesTransfer') `+
+			`GROUP BY ps.security_uuid`+
+			`) h ON h.security_uuid = e.security_uuid AND h.shares > 0`, portfolio.ID)
+		selects += ", h.shares"
+	}
+
+	q = q.Session(&gorm.Session{})
+
+	var totalCount int64
+	if err := q.Count(&totalCount).Error; err != nil {
+		panic(err)
+	}
+
+	var rows []struct {
+		db.Event
+		SecurityName *string
+		Isin         *string
+		Extras       datatypes.JSON
+		Shares       decimal.NullDecimal
+	}
+	q = q.Select(selects).Order("e.date, s.name, e.id").Offset(query.Skip).Limit(query.Limit)
+	if err := q.Find(&rows).Error; err != nil {
+		panic(err)
+	}
+
+	entries := make([]*model.CalendarEvent, len(rows))
+	for i, r := range rows {
+		entry := &model.CalendarEvent{
+			SecurityUUID: uuid.MustParse(r.SecurityUuid),
+			SecurityName: r.SecurityName,
+			Isin:         r.Isin,
+			LogoURL:      s.SecurityService.LogoFromExtras(r.Extras).LogoURL,
+			Date:         r.Date,
+			Type:         r.Type,
+			Amount:       r.Amount,
+			CurrencyCode: r.CurrencyCode,
+			Ratio:        r.Ratio,
+		}
+		if r.Shares.Valid {
+			shares := r.Shares.Decimal
+			entry.Shares = &shares
+			entry.ExpectedIncome = s.expectedIncome(&r.Event, shares, portfolio.BaseCurrencyCode)
+		}
+		entries[i] = entry
+	}
+
+	return &model.EventCalendar{
+		Entries:    entries,
+		TotalCount: int(totalCount),
+	}
+}
+
+// expectedIncome calculates income of dividend for shares in currency,
+// returns nil for other events or if amount cannot be converted
+func (s *eventService) expectedIncome(e *db.Event, shares decimal.Decimal, currencyCode string) *decimal.Decimal {
+	if e.Type != "dividend" || e.Amount == nil || e.CurrencyCode == nil {
+		return nil
+	}
+
+	amount, err := decimal.NewFromString(*e.Amount)
+	if err != nil {
+		return nil
+	}
+	income, err := s.CurrenciesService.ConvertCurrencyAmount(
+		amount.Mul(shares), *e.CurrencyCode, currencyCode, e.Date.Time())
+	if err != nil {
+		return nil
+	}
+	income = income.Round(2)
+	return &income
+}
+
+// checkSecurity returns ErrNotFound if security does not exist
+func (*eventService) checkSecurity(tx *gorm.DB, securityUuid uuid.UUID) error {
+	var count int64
+	if err := tx.Model(&db.Security{}).Where("uuid = ?", securityUuid).Count(&count).Error; err != nil {
+		panic(err)
+	}
+	if count == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// eventFromInput validates input and converts it into event for database
+func (*eventService) eventFromInput(tx *gorm.DB, securityUuid uuid.UUID, input *model.SecurityEventInput) (db.Event, error) {
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return db.Event{}, fmt.Errorf("invalid date %s", input.Date)
+	}
+
+	event := db.Event{
+		Date:         model.Date(date),
+		Type:         input.Type,
+		SecurityUuid: securityUuid.String(),
+	}
+
+	switch input.Type {
+	case "dividend":
+		if input.Amount == nil || input.CurrencyCode == nil {
+			return db.Event{}, errors.New("dividend requires amount and currencyCode")
+		}
+		if input.Ratio != nil {
+			return db.Event{}, errors.New("dividend must not have ratio")
+		}
+
+		amount, err := decimal.NewFromString(*input.Amount)
+		if err != nil || !amount.IsPositive() || !amount.LessThan(maxEventAmount) {
+			return db.Event{}, fmt.Errorf("invalid amount %s", *input.Amount)
+		}
+		if !amount.Equal(amount.Round(4)) {
+			return db.Event{}, fmt.Errorf("amount %s has more than 4 decimal places", *input.Amount)
+		}
+
+		var count int64
+		if err := tx.Model(&db.Currency{}).Where("code = ?", *input.CurrencyCode).Count(&count).Error; err != nil {
+			panic(err)
+		}
+		if count == 0 {
+			return db.Event{}, fmt.Errorf("unknown currency code %s", *input.CurrencyCode)
+		}
+
+		amountStr := amount.String()
+		event.Amount = &amountStr
+		event.CurrencyCode = input.CurrencyCode
+
+	case "split":
+		if input.Ratio == nil {
+			return db.Event{}, errors.New("split requires ratio")
+		}
+		if input.Amount != nil || input.CurrencyCode != nil {
+			return db.Event{}, errors.New("split must not have amount or currencyCode")
+		}
+		if !strings.Contains(*input.Ratio, ":") {
+			return db.Event{}, fmt.Errorf("ratio %s is not in form n:m", *input.Ratio)
+		}
+		if _, err := parseSplitRatio(*input.Ratio); err != nil {
+			return db.Event{}, err
+		}
+		event.Ratio = input.Ratio
+
+	default:
+		return db.Event{}, fmt.Errorf("unknown type %s", input.Type)
+	}
+
+	return event, nil
+}
@@ -1,15 +1,24 @@
 package service
 
 import (
+	"bytes"
+	"embed"
 	"errors"
 	"fmt"
 	"net/smtp"
 	"regexp"
+	"strings"
+	"text/template"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/portfolio-report/pr-api/graph/model"
 )
 
+//go:embed templates/*.txt
+var mailTemplatesFS embed.FS
+
+var mailTemplates = template.Must(template.ParseFS(mailTemplatesFS, "templates/*.txt"))
+
 type mailerService struct {
 	Host           string
 	Port           string
@@ -45,11 +54,6 @@ func NewMailerService(config string, recipientEmail string, validate *validator.
 
 // SendContactMail sends an email to the default contact email address
 func (s *mailerService) SendContactMail(senderEmail string, senderName string, subject string, message string, ip string) error {
-	var auth smtp.Auth
-	if s.User != "" {
-		auth = smtp.PlainAuth("", s.User, s.Pass, s.Host)
-	}
-
 	msg := []byte("To: " + s.RecipientEmail + "\r\n" +
 		"From: " + senderName + " via Portfolio Report <" + s.RecipientEmail + ">\r\n" +
 		"Reply-To: " + senderName + " <" + senderEmail + ">\r\n" +
@@ -59,11 +63,58 @@ func (s *mailerService) SendContactMail(senderEmail string, senderName string, s
 		"\r\n" +
 		message + "\r\n")
 
-	// Use recipient email as recipient and envelope sender!
-	err := smtp.SendMail(s.Host+":"+s.Port, auth, s.RecipientEmail, []string{s.RecipientEmail}, msg)
+	return s.send(s.RecipientEmail, msg)
+}
+
+// SendMail renders template with data and sends it to recipient,
+// the default contact email address is used as sender
+func (s *mailerService) SendMail(recipientEmail string, subject string, templateName string, data any) error {
+	body, err := renderMail(templateName, data)
+	if err != nil {
+		return err
+	}
+
+	// Prevent header injection
+	recipientEmail = stripNewlines(recipientEmail)
+	subject = stripNewlines(subject)
+
+	msg := []byte("To: " + recipientEmail + "\r\n" +
+		"From: Portfolio Report <" + s.RecipientEmail + ">\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" +
+		body + "\r\n")
+
+	return s.send(recipientEmail, msg)
+}
+
+// send delivers message to recipient
+func (s *mailerService) send(recipientEmail string, msg []byte) error {
+	var auth smtp.Auth
+	if s.User != "" {
+		auth = smtp.PlainAuth("", s.User, s.Pass, s.Host)
+	}
+
+	// Use default contact email address as envelope sender!
+	err := smtp.SendMail(s.Host+":"+s.Port, auth, s.RecipientEmail, []string{recipientEmail}, msg)
 
 	if err != nil {
 		return fmt.Errorf("could not send email (host: %s): %w", s.Host, err)
 	}
 	return nil
 }
+
+// renderMail executes mail template with data
+func renderMail(templateName string, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := mailTemplates.ExecuteTemplate(&buf, templateName, data); err != nil {
+		return "", fmt.Errorf("could not render mail template %s: %w", templateName, err)
+	}
+	return strings.ReplaceAll(buf.String(), "\n", "\r\n"), nil
+}
+
+// stripNewlines removes line breaks from header values
+func stripNewlines(s string) string {
+	s = strings.ReplaceAll(s, "\n", "")
+	return strings.ReplaceAll(s, "\r", "")
+}
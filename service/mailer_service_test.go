@@ -49,3 +49,28 @@ func TestNewMailerService(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderMail(t *testing.T) {
+	body, err := renderMail("alert.txt", alertNotification{
+		SecurityName: "Test security",
+		MarketCode:   "XETR",
+		Type:         "priceAbove",
+		Threshold:    "100",
+		Date:         "2020-01-02",
+		Value:        "101.5",
+		CurrencyCode: "EUR",
+	})
+	require.Nil(t, err)
+	assert.Contains(t, body, "your alert on Test security (XETR) has been triggered")
+	assert.Contains(t, body, "The price closed at 101.5 EUR on 2020-01-02, above your threshold of 100 EUR.")
+	assert.NotContains(t, body, "below")
+	assert.NotContains(t, body, "\n\n", "lines must be terminated by CRLF")
+
+	body, err = renderMail("email_verification.txt", map[string]string{"Username": "testuser", "Token": "abc123"})
+	require.Nil(t, err)
+	assert.Contains(t, body, "Hello testuser,")
+	assert.Contains(t, body, "\r\nabc123\r\n")
+
+	_, err = renderMail("unknown.txt", nil)
+	assert.Error(t, err)
+}
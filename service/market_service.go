@@ -0,0 +1,236 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	_ "time/tzdata" // timezones of markets are validated independent of host
+
+	"github.com/lib/pq"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs/calendar"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type marketService struct {
+	DB *gorm.DB
+}
+
+// NewMarketService creates and returns new market service
+func NewMarketService(db *gorm.DB) model.MarketService {
+	return &marketService{
+		DB: db,
+	}
+}
+
+// modelFromDb converts market from database into model
+func (*marketService) modelFromDb(m db.Market) *model.Market {
+	return &model.Market{
+		Code:         m.Code,
+		Name:         m.Name,
+		Mic:          m.Mic,
+		CountryCode:  m.CountryCode,
+		Timezone:     m.Timezone,
+		CurrencyCode: m.CurrencyCode,
+		OpenTime:     m.OpenTime,
+		CloseTime:    m.CloseTime,
+		Calendar:     m.Calendar,
+	}
+}
+
+// GetMarkets returns all markets
+func (s *marketService) GetMarkets() []*model.Market {
+	var markets []db.Market
+	if err := s.DB.Order("code").Find(&markets).Error; err != nil {
+		panic(err)
+	}
+
+	ret := make([]*model.Market, len(markets))
+	for i := range markets {
+		ret[i] = s.modelFromDb(markets[i])
+	}
+	return ret
+}
+
+// GetMarket returns market identified by code
+func (s *marketService) GetMarket(code string) (*model.Market, error) {
+	var market db.Market
+	if err := s.DB.Take(&market, "code = ?", code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrNotFound
+		}
+		panic(err)
+	}
+	return s.modelFromDb(market), nil
+}
+
+// CreateMarket creates new market
+func (s *marketService) CreateMarket(input *model.MarketInput) (*model.Market, error) {
+	market, err := s.marketFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.Create(&market).Error; err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505":
+				return nil, fmt.Errorf("market %s already exists", market.Code)
+			case "23503":
+				return nil, fmt.Errorf("data violates constraint %s", pqErr.Constraint)
+			}
+		}
+		panic(err)
+	}
+
+	return s.modelFromDb(market), nil
+}
+
+// UpdateMarket updates market, changing its code renames it in all references
+func (s *marketService) UpdateMarket(code string, input *model.MarketInput) (*model.Market, error) {
+	market, err := s.marketFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.DB.
+		Model(&db.Market{}).
+		Clauses(clause.Returning{}).
+		Where("code = ?", code).
+		Select("*").
+		Updates(&market)
+	if err := result.Error; err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505":
+				return nil, fmt.Errorf("market %s already exists", market.Code)
+			case "23503":
+				return nil, fmt.Errorf("data violates constraint %s", pqErr.Constraint)
+			}
+		}
+		panic(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return s.modelFromDb(market), nil
+}
+
+// DeleteMarket removes market, markets used by securities cannot be deleted
+func (s *marketService) DeleteMarket(code string) (*model.Market, error) {
+	var count int64
+	if err := s.DB.Model(&db.SecurityMarket{}).Where("market_code = ?", code).Count(&count).Error; err != nil {
+		panic(err)
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("market %s is used by %d securities", code, count)
+	}
+
+	var market db.Market
+	result := s.DB.Clauses(clause.Returning{}).Delete(&market, "code = ?", code)
+	if err := result.Error; err != nil {
+		panic(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return s.modelFromDb(market), nil
+}
+
+// marketFromInput validates input and converts it into market for database
+func (*marketService) marketFromInput(input *model.MarketInput) (db.Market, error) {
+	if input.Timezone != nil {
+		if _, err := time.LoadLocation(*input.Timezone); err != nil {
+			return db.Market{}, fmt.Errorf("invalid timezone %s", *input.Timezone)
+		}
+	}
+	if input.Calendar != nil {
+		if _, ok := calendar.Get(*input.Calendar); !ok {
+			return db.Market{}, fmt.Errorf("unknown calendar %s", *input.Calendar)
+		}
+	}
+
+	return db.Market{
+		Code:         input.Code,
+		Name:         input.Name,
+		Mic:          input.Mic,
+		CountryCode:  input.CountryCode,
+		Timezone:     input.Timezone,
+		CurrencyCode: input.CurrencyCode,
+		OpenTime:     input.OpenTime,
+		CloseTime:    input.CloseTime,
+		Calendar:     input.Calendar,
+	}, nil
+}
+
+// GetMarketCalendar returns holidays and number of trading days of market in year
+func (s *marketService) GetMarketCalendar(marketCode string, year int) (*model.MarketCalendar, error) {
+	var market db.Market
+	if err := s.DB.Take(&market, "code = ?", marketCode).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrNotFound
+		}
+		panic(err)
+	}
+
+	cal := calendarOfMarket(market)
+
+	holidays := []*model.MarketHoliday{}
+	for _, h := range cal.Holidays(year) {
+		holidays = append(holidays, &model.MarketHoliday{
+			Date: model.Date(h.Date),
+			Name: h.Name,
+		})
+	}
+
+	return &model.MarketCalendar{
+		MarketCode:   market.Code,
+		Calendar:     cal.Code,
+		CalendarName: cal.Name,
+		Year:         year,
+		TradingDays: cal.CountTradingDays(
+			time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)),
+		Holidays: holidays,
+	}, nil
+}
+
+// GetTradingDays lists trading days of market between from and to (both inclusive),
+// unknown markets use default calendar
+func (s *marketService) GetTradingDays(marketCode string, from, to time.Time) []time.Time {
+	market := db.Market{Code: marketCode}
+	if err := s.DB.Limit(1).Find(&market, "code = ?", marketCode).Error; err != nil {
+		panic(err)
+	}
+
+	return calendarOfMarket(market).TradingDays(from, to)
+}
+
+// calendarOfMarket returns calendar configured for market,
+// falls back to calendar derived from market code
+func calendarOfMarket(m db.Market) *calendar.Calendar {
+	if m.Calendar != nil {
+		if c, ok := calendar.Get(*m.Calendar); ok {
+			return c
+		}
+	}
+	return calendar.ForMarket(m.Code)
+}
+
+// marketCalendars returns calendars of all markets by market code
+func marketCalendars(tx *gorm.DB) map[string]*calendar.Calendar {
+	var markets []db.Market
+	if err := tx.Find(&markets).Error; err != nil {
+		panic(err)
+	}
+
+	calendars := make(map[string]*calendar.Calendar, len(markets))
+	for _, m := range markets {
+		calendars[m.Code] = calendarOfMarket(m)
+	}
+	return calendars
+}
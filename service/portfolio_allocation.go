@@ -0,0 +1,283 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs/calendar"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// maxLookThroughDepth limits nesting of funds of funds in look-through
+const maxLookThroughDepth = 3
+
+var hundred = decimal.NewFromInt(100)
+
+// weightedUUID is security or taxonomy with weight in percent
+type weightedUUID struct {
+	UUID   uuid.UUID
+	Weight decimal.Decimal
+}
+
+// allocation holds classification of securities within root taxonomy
+// and constituents of funds known in master data
+type allocation struct {
+	taxonomies map[uuid.UUID][]weightedUUID
+	holdings   map[uuid.UUID][]weightedUUID
+	values     map[uuid.UUID]decimal.Decimal
+}
+
+// add allocates value of security to its taxonomies, funds are allocated by their
+// constituents first and the remainder (constituents not in master data, cash etc.)
+// by taxonomies of fund. Value not classified is allocated to uuid.Nil.
+func (a *allocation) add(securityUuid uuid.UUID, value decimal.Decimal, depth int) {
+	if holdings := a.holdings[securityUuid]; len(holdings) > 0 && depth < maxLookThroughDepth {
+		// Weights may exceed 100 by rounding of fund
+		total := decimal.Zero
+		for _, h := range holdings {
+			total = total.Add(h.Weight)
+		}
+		divisor := decimal.Max(total, hundred)
+
+		remainder := value
+		for _, h := range holdings {
+			part := value.Mul(h.Weight).Div(divisor)
+			a.add(h.UUID, part, depth+1)
+			remainder = remainder.Sub(part)
+		}
+		if !remainder.IsPositive() {
+			return
+		}
+		value = remainder
+	}
+
+	classified := decimal.Zero
+	for _, t := range a.taxonomies[securityUuid] {
+		part := value.Mul(t.Weight).Div(hundred)
+		a.values[t.UUID] = a.values[t.UUID].Add(part)
+		classified = classified.Add(part)
+	}
+	if unclassified := value.Sub(classified); unclassified.IsPositive() {
+		a.values[uuid.Nil] = a.values[uuid.Nil].Add(unclassified)
+	}
+}
+
+// GetPortfolioAllocation values current holdings of portfolio by latest price in
+// base currency of portfolio and allocates them to taxonomies of root taxonomy.
+// Latest price is carried forward to last trading day according to calendar of
+// portfolio security or, if not set, of market, and converted at that day.
+// With lookThrough, funds are allocated by taxonomies of their constituents.
+func (s *portfolioService) GetPortfolioAllocation(
+	portfolioId int, rootTaxonomyUuid uuid.UUID, lookThrough bool,
+) (*model.PortfolioAllocation, error) {
+	var portfolio db.Portfolio
+	if err := s.DB.Take(&portfolio, portfolioId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrNotFound
+		}
+		panic(err)
+	}
+
+	var root db.Taxonomy
+	if err := s.DB.Take(&root, "uuid = ? AND root_uuid IS NULL", rootTaxonomyUuid).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("root taxonomy does not exist")
+		}
+		panic(err)
+	}
+
+	// Current holdings of portfolio with latest price of security in master data
+	var positions []struct {
+		UUID         uuid.UUID
+		SecurityUUID *uuid.UUID
+		Calendar     *string
+		Shares       decimal.Decimal
+		MarketCode   *string
+		CurrencyCode *string
+		Date         *model.Date
+		Close        decimal.NullDecimal
+	}
+	if err := s.DB.Raw(`
+		SELECT ps.uuid, ps.security_uuid, ps.calendar, h.shares, p.market_code, p.currency_code, p.date, p.close
+		FROM portfolios_securities ps
+		INNER JOIN (
+			SELECT portfolio_security_uuid, SUM(shares) AS shares
+			FROM portfolios_transactions
+			WHERE portfolio_id = @portfolio AND type IN ('SecuritiesOrder', 'SecuritiesTransfer')
+			GROUP BY portfolio_security_uuid
+		) h ON h.portfolio_security_uuid = ps.uuid AND h.shares > 0
+		LEFT JOIN LATERAL (
+			SELECT m.market_code, m.currency_code, mp.date, mp.close
+			FROM securities_markets m
+			INNER JOIN securities_markets_prices mp ON mp.security_market_id = m.id AND mp.date = m.last_price_date
+			WHERE m.security_uuid = ps.security_uuid
+			ORDER BY m.last_price_date DESC, m.market_code COLLATE "C"
+			LIMIT 1
+		) p ON TRUE
+		WHERE ps.portfolio_id = @portfolio
+		ORDER BY ps.uuid`, map[string]interface{}{"portfolio": portfolioId}).
+		Scan(&positions).Error; err != nil {
+		panic(err)
+	}
+
+	result := &model.PortfolioAllocation{
+		RootTaxonomyUUID:   rootTaxonomyUuid,
+		CurrencyCode:       portfolio.BaseCurrencyCode,
+		LookThrough:        lookThrough,
+		Entries:            []*model.PortfolioAllocationEntry{},
+		UnvaluedSecurities: []uuid.UUID{},
+	}
+
+	calendars := marketCalendars(s.DB)
+	now := time.Now()
+
+	values := map[uuid.UUID]decimal.Decimal{}
+	for _, p := range positions {
+		if p.SecurityUUID == nil || !p.Close.Valid {
+			result.UnvaluedSecurities = append(result.UnvaluedSecurities, p.UUID)
+			continue
+		}
+		date := valuationDate(p.Calendar, calendars, *p.MarketCode, p.Date.Time(), now)
+		value, err := s.CurrenciesService.ConvertCurrencyAmount(
+			p.Shares.Mul(p.Close.Decimal), *p.CurrencyCode, portfolio.BaseCurrencyCode, date)
+		if err != nil {
+			result.UnvaluedSecurities = append(result.UnvaluedSecurities, p.UUID)
+			continue
+		}
+		values[*p.SecurityUUID] = values[*p.SecurityUUID].Add(value)
+	}
+
+	a := &allocation{
+		taxonomies: map[uuid.UUID][]weightedUUID{},
+		holdings:   map[uuid.UUID][]weightedUUID{},
+		values:     map[uuid.UUID]decimal.Decimal{},
+	}
+
+	securityUuids := []uuid.UUID{}
+	for u := range values {
+		securityUuids = append(securityUuids, u)
+	}
+	if lookThrough {
+		// Load constituents level by level, each fund once
+		pending := securityUuids
+		for depth := 0; depth < maxLookThroughDepth && len(pending) > 0; depth++ {
+			var holdings []db.SecurityHolding
+			if err := s.DB.Find(&holdings, "security_uuid IN ? AND constituent_uuid IS NOT NULL", pending).Error; err != nil {
+				panic(err)
+			}
+			pending = []uuid.UUID{}
+			for _, h := range holdings {
+				a.holdings[h.SecurityUUID] = append(a.holdings[h.SecurityUUID],
+					weightedUUID{UUID: *h.ConstituentUUID, Weight: h.Weight})
+				if _, ok := a.holdings[*h.ConstituentUUID]; !ok {
+					pending = append(pending, *h.ConstituentUUID)
+				}
+				securityUuids = append(securityUuids, *h.ConstituentUUID)
+			}
+		}
+	}
+
+	if len(securityUuids) > 0 {
+		var taxonomies []db.SecurityTaxonomy
+		if err := s.DB.Table("securities_taxonomies st").
+			Select("st.*").
+			Joins("INNER JOIN taxonomies t ON t.uuid = st.taxonomy_uuid").
+			Where("t.root_uuid = ? AND st.security_uuid IN ?", rootTaxonomyUuid, securityUuids).
+			Find(&taxonomies).Error; err != nil {
+			panic(err)
+		}
+		for _, t := range taxonomies {
+			a.taxonomies[t.SecurityUUID] = append(a.taxonomies[t.SecurityUUID],
+				weightedUUID{UUID: t.TaxonomyUUID, Weight: t.Weight})
+		}
+	}
+
+	total := decimal.Zero
+	for securityUuid, value := range values {
+		a.add(securityUuid, value, 0)
+		total = total.Add(value)
+	}
+	result.TotalValue = total.Round(2)
+	if !total.IsPositive() {
+		return result, nil
+	}
+
+	var names []db.Taxonomy
+	if err := s.DB.Find(&names, "root_uuid = ?", rootTaxonomyUuid).Error; err != nil {
+		panic(err)
+	}
+	nameByUuid := map[uuid.UUID]string{}
+	for _, t := range names {
+		nameByUuid[t.UUID] = t.Name
+	}
+
+	for taxonomyUuid, value := range a.values {
+		entry := &model.PortfolioAllocationEntry{
+			Value:  value.Round(2),
+			Weight: value.Mul(hundred).Div(total).Round(2),
+		}
+		if taxonomyUuid != uuid.Nil {
+			taxonomyUuid := taxonomyUuid
+			name := nameByUuid[taxonomyUuid]
+			entry.TaxonomyUUID = &taxonomyUuid
+			entry.Name = &name
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	sortAllocationEntries(result.Entries)
+
+	return result, nil
+}
+
+// valuationDate returns date at which latest price of position is valued, the last
+// trading day until now according to calendar of portfolio security, falling back
+// to calendar of market. Price dated later (e.g. on non-trading day) is valued at its date.
+func valuationDate(
+	portfolioSecurityCalendar *string,
+	calendars map[string]*calendar.Calendar,
+	marketCode string,
+	priceDate time.Time,
+	now time.Time,
+) time.Time {
+	cal, ok := calendars[marketCode]
+	if !ok {
+		cal = calendar.ForMarket(marketCode)
+	}
+	if portfolioSecurityCalendar != nil {
+		if c, ok := calendar.Get(*portfolioSecurityCalendar); ok {
+			cal = c
+		}
+	}
+
+	date := cal.PreviousTradingDay(now)
+	if priceDate.After(date) {
+		return priceDate
+	}
+	return date
+}
+
+// sortAllocationEntries sorts entries by value descending, then by name,
+// unclassified value last among equal values
+func sortAllocationEntries(entries []*model.PortfolioAllocationEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if !a.Value.Equal(b.Value) {
+			return a.Value.GreaterThan(b.Value)
+		}
+		if (a.Name == nil) != (b.Name == nil) {
+			return b.Name == nil
+		}
+		if a.Name != nil && *a.Name != *b.Name {
+			return *a.Name < *b.Name
+		}
+		if a.TaxonomyUUID != nil && b.TaxonomyUUID != nil {
+			return a.TaxonomyUUID.String() < b.TaxonomyUUID.String()
+		}
+		return false
+	})
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/portfolio-report/pr-api/libs/calendar"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValuationDate(t *testing.T) {
+	a := assert.New(t)
+
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	code := func(s string) *string { return &s }
+
+	// Independence Day is holiday at NYSE only
+	now := time.Date(2022, 7, 4, 12, 0, 0, 0, time.UTC)
+	priceDate := date("2022-06-30")
+	calendars := map[string]*calendar.Calendar{}
+	calendars["TEST"], _ = calendar.Get("de")
+
+	a.Equal(date("2022-07-04"), valuationDate(nil, calendars, "TEST", priceDate, now))
+	a.Equal(date("2022-07-01"), valuationDate(code("nyse"), calendars, "TEST", priceDate, now))
+	a.Equal(date("2022-07-04"), valuationDate(code("unknown"), calendars, "TEST", priceDate, now))
+	a.Equal(date("2022-07-01"), valuationDate(nil, calendars, "XNYS", priceDate, now))
+	a.Equal(date("2022-07-04"), valuationDate(code("nyse"), calendars, "TEST", date("2022-07-04"), now))
+}
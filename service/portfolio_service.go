@@ -17,13 +17,15 @@ import (
 )
 
 type portfolioService struct {
-	DB *gorm.DB
+	DB                *gorm.DB
+	CurrenciesService model.CurrenciesService
 }
 
 // NewPortfolioService creates and returns new portfolio service
-func NewPortfolioService(db *gorm.DB) model.PortfolioService {
+func NewPortfolioService(db *gorm.DB, currenciesService model.CurrenciesService) model.PortfolioService {
 	return &portfolioService{
-		DB: db,
+		DB:                db,
+		CurrenciesService: currenciesService,
 	}
 }
 
@@ -219,6 +221,146 @@ func (s *portfolioService) DeletePortfolio(ID uint) *model.Portfolio {
 	return s.modelFromDb(portfolio)
 }
 
+// ClonePortfolio creates deep copy of portfolio incl. accounts, securities and
+// (optionally) transactions. All UUIDs are regenerated and references remapped.
+func (s *portfolioService) ClonePortfolio(user *model.User, ID uint, input *model.PortfolioCloneInput) (*model.Portfolio, error) {
+	var source db.Portfolio
+	if err := s.DB.Take(&source, "user_id = ? AND id = ?", user.ID, ID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		panic(err)
+	}
+
+	portfolio := db.Portfolio{
+		Name:             source.Name + " (copy)",
+		Note:             source.Note,
+		BaseCurrencyCode: source.BaseCurrencyCode,
+		UserID:           uint(user.ID),
+	}
+	if input.Name != nil {
+		portfolio.Name = *input.Name
+	}
+
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Returning{}).Create(&portfolio).Error; err != nil {
+			return err
+		}
+
+		// Accounts, all references are within the same statement
+		var accounts []db.PortfolioAccount
+		if err := tx.Find(&accounts, "portfolio_id = ?", source.ID).Error; err != nil {
+			return err
+		}
+		accountUuids := make(map[uuid.UUID]uuid.UUID, len(accounts))
+		for _, a := range accounts {
+			accountUuids[a.UUID] = uuid.New()
+		}
+		for i := range accounts {
+			accounts[i].PortfolioID = portfolio.ID
+			accounts[i].UUID = accountUuids[accounts[i].UUID]
+			if accounts[i].ReferenceAccountUUID != nil {
+				ref := accountUuids[*accounts[i].ReferenceAccountUUID]
+				accounts[i].ReferenceAccountUUID = &ref
+			}
+		}
+		if len(accounts) > 0 {
+			if err := tx.Create(&accounts).Error; err != nil {
+				return err
+			}
+		}
+
+		// Securities
+		var securities []db.PortfolioSecurity
+		if err := tx.Find(&securities, "portfolio_id = ?", source.ID).Error; err != nil {
+			return err
+		}
+		securityUuids := make(map[uuid.UUID]uuid.UUID, len(securities))
+		for i := range securities {
+			newUuid := uuid.New()
+			securityUuids[securities[i].UUID] = newUuid
+			securities[i].PortfolioID = portfolio.ID
+			securities[i].UUID = newUuid
+		}
+		if len(securities) > 0 {
+			if err := tx.CreateInBatches(&securities, cloneBatchSize).Error; err != nil {
+				return err
+			}
+		}
+
+		if input.SkipTransactions {
+			return nil
+		}
+
+		// Transactions, partner links are set after all transactions exist
+		var transactions []db.PortfolioTransaction
+		if err := tx.Preload("Units").Find(&transactions, "portfolio_id = ?", source.ID).Error; err != nil {
+			return err
+		}
+		transactionUuids := make(map[uuid.UUID]uuid.UUID, len(transactions))
+		for _, t := range transactions {
+			transactionUuids[t.UUID] = uuid.New()
+		}
+		units := []db.PortfolioTransactionUnit{}
+		partnerLinks := [][]interface{}{}
+		for i := range transactions {
+			t := &transactions[i]
+			t.PortfolioID = portfolio.ID
+			t.UUID = transactionUuids[t.UUID]
+			t.AccountUUID = accountUuids[t.AccountUUID]
+			if t.PortfolioSecurityUUID != nil {
+				ref := securityUuids[*t.PortfolioSecurityUUID]
+				t.PortfolioSecurityUUID = &ref
+			}
+			if t.PartnerTransactionUUID != nil {
+				partnerLinks = append(partnerLinks,
+					[]interface{}{t.UUID, transactionUuids[*t.PartnerTransactionUUID]})
+				t.PartnerTransactionUUID = nil
+			}
+			for _, u := range t.Units {
+				u.ID = 0
+				u.PortfolioID = portfolio.ID
+				u.TransactionUUID = t.UUID
+				units = append(units, u)
+			}
+			t.Units = nil
+		}
+		if len(transactions) > 0 {
+			if err := tx.CreateInBatches(&transactions, cloneBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(units) > 0 {
+			if err := tx.CreateInBatches(&units, cloneBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		for start := 0; start < len(partnerLinks); start += cloneBatchSize {
+			end := start + cloneBatchSize
+			if end > len(partnerLinks) {
+				end = len(partnerLinks)
+			}
+			if err := tx.Exec(`UPDATE portfolios_transactions t `+
+				`SET partner_transaction_uuid = v.partner_uuid::uuid `+
+				`FROM (VALUES ?) AS v(uuid, partner_uuid) `+
+				`WHERE t.portfolio_id = ? AND t.uuid = v.uuid::uuid`,
+				partnerLinks[start:end], portfolio.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return s.modelFromDb(portfolio), nil
+}
+
+// cloneBatchSize limits number of rows per statement when cloning portfolios
+const cloneBatchSize = 1000
+
 // GetPortfolioAccountsOfPortfolio lists all account in portfolio
 func (s *portfolioService) GetPortfolioAccountsOfPortfolio(portfolioId int) []*model.PortfolioAccount {
 	var accounts []db.PortfolioAccount
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const (
+	// priceAdjustmentsTTL limits age of cached adjustment factors, changes
+	// not invalidated explicitly are reflected after it at the latest
+	priceAdjustmentsTTL = time.Hour
+	// maxCachedPriceAdjustments limits number of securities with cached adjustment factors
+	maxCachedPriceAdjustments = 10000
+)
+
+// cachedPriceAdjustments holds adjustment factors of security by market code/adjustment
+type cachedPriceAdjustments struct {
+	expiresAt time.Time
+	factors   map[string][]*model.PriceAdjustmentFactor
+}
+
+// GetPriceAdjustmentFactors returns factors to back-adjust prices of security
+// market for splits and optionally dividends, sorted by date ascending.
+// Factors are cached per security market until invalidated or expired.
+func (s *priceService) GetPriceAdjustmentFactors(
+	securityUUID, marketCode string,
+	adjustment model.PriceAdjustment,
+) ([]*model.PriceAdjustmentFactor, error) {
+	if !adjustment.IsValid() {
+		return nil, fmt.Errorf("unknown adjustment %s", adjustment)
+	}
+
+	key := marketCode + "/" + string(adjustment)
+
+	if factors, ok := s.cachedPriceAdjustmentFactors(securityUUID, key, time.Now()); ok {
+		return factors, nil
+	}
+
+	var market db.SecurityMarket
+	err := s.DB.Take(&market, "security_uuid = ? AND market_code = ?", securityUUID, marketCode).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, model.ErrNotFound
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	factors, err := s.calculatePriceAdjustmentFactors(&market, adjustment)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cachePriceAdjustmentFactors(securityUUID, key, factors, time.Now())
+
+	return factors, nil
+}
+
+// cachedPriceAdjustmentFactors returns cached factors of security by market code/adjustment
+func (s *priceService) cachedPriceAdjustmentFactors(
+	securityUUID, key string, now time.Time,
+) ([]*model.PriceAdjustmentFactor, bool) {
+	s.adjustmentsMu.Lock()
+	defer s.adjustmentsMu.Unlock()
+
+	entry, ok := s.adjustments[securityUUID]
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, false
+	}
+	factors, ok := entry.factors[key]
+	return factors, ok
+}
+
+// cachePriceAdjustmentFactors stores factors of security by market code/adjustment,
+// expired entries are removed if cache is full and arbitrary ones if still full
+func (s *priceService) cachePriceAdjustmentFactors(
+	securityUUID, key string, factors []*model.PriceAdjustmentFactor, now time.Time,
+) {
+	s.adjustmentsMu.Lock()
+	defer s.adjustmentsMu.Unlock()
+
+	entry, ok := s.adjustments[securityUUID]
+	if !ok || !now.Before(entry.expiresAt) {
+		if len(s.adjustments) >= maxCachedPriceAdjustments {
+			for u, e := range s.adjustments {
+				if !now.Before(e.expiresAt) {
+					delete(s.adjustments, u)
+				}
+			}
+		}
+		for u := range s.adjustments {
+			if len(s.adjustments) < maxCachedPriceAdjustments {
+				break
+			}
+			delete(s.adjustments, u)
+		}
+
+		entry = &cachedPriceAdjustments{
+			expiresAt: now.Add(priceAdjustmentsTTL),
+			factors:   map[string][]*model.PriceAdjustmentFactor{},
+		}
+		s.adjustments[securityUUID] = entry
+	}
+	entry.factors[key] = factors
+}
+
+// InvalidatePriceAdjustments removes cached adjustment factors of all markets
+// of security, must be called when prices or events of security change
+func (s *priceService) InvalidatePriceAdjustments(securityUUID string) {
+	s.adjustmentsMu.Lock()
+	defer s.adjustmentsMu.Unlock()
+
+	delete(s.adjustments, securityUUID)
+}
+
+// calculatePriceAdjustmentFactors walks events from newest to oldest and
+// accumulates factors of splits and (for total) dividends
+func (s *priceService) calculatePriceAdjustmentFactors(
+	market *db.SecurityMarket,
+	adjustment model.PriceAdjustment,
+) ([]*model.PriceAdjustmentFactor, error) {
+	var events []db.Event
+	if err := s.DB.
+		Where("security_uuid = ? AND type IN ?", market.SecurityUUID, []string{"split", "dividend"}).
+		Order("date DESC, id DESC").
+		Find(&events).Error; err != nil {
+		panic(err)
+	}
+
+	factors := []*model.PriceAdjustmentFactor{}
+	factor, volumeFactor := decimal.NewFromInt(1), decimal.NewFromInt(1)
+
+	for _, e := range events {
+		switch e.Type {
+		case "split":
+			if e.Ratio == nil {
+				continue
+			}
+			ratio, err := parseSplitRatio(*e.Ratio)
+			if err != nil {
+				return nil, fmt.Errorf("split at %s: %w", e.Date, err)
+			}
+			factor = factor.Div(ratio)
+			volumeFactor = volumeFactor.Mul(ratio)
+
+		case "dividend":
+			if adjustment != model.PriceAdjustmentTotal || e.Amount == nil {
+				continue
+			}
+			f, err := s.dividendFactor(market, &e)
+			if err != nil {
+				return nil, err
+			}
+			if f == nil {
+				continue
+			}
+			factor = factor.Mul(*f)
+
+		default:
+			continue
+		}
+
+		// Events at same date are combined into one factor
+		if n := len(factors); n > 0 && factors[n-1].Date.Equal(e.Date) {
+			factors[n-1].Factor = factor
+			factors[n-1].VolumeFactor = volumeFactor
+		} else {
+			factors = append(factors, &model.PriceAdjustmentFactor{
+				Date:         e.Date,
+				Factor:       factor,
+				VolumeFactor: volumeFactor,
+			})
+		}
+	}
+
+	// Return ascending by date
+	for i, j := 0, len(factors)-1; i < j; i, j = i+1, j-1 {
+		factors[i], factors[j] = factors[j], factors[i]
+	}
+	return factors, nil
+}
+
+// dividendFactor calculates factor 1 - dividend / previous close for dividend
+// with ex-date of event, dividend is converted into currency of market.
+// Returns nil if there is no close before ex-date.
+func (s *priceService) dividendFactor(market *db.SecurityMarket, e *db.Event) (*decimal.Decimal, error) {
+	var previous db.SecurityMarketPrice
+	result := s.DB.
+		Where("security_market_id = ? AND date < ?", market.ID, e.Date).
+		Order("date DESC").Limit(1).
+		Find(&previous)
+	if result.Error != nil {
+		panic(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	closePrice, err := decimal.NewFromString(string(previous.Close))
+	if err != nil {
+		panic(err)
+	}
+	if !closePrice.IsPositive() {
+		return nil, nil
+	}
+
+	amount, err := decimal.NewFromString(*e.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("dividend at %s: invalid amount %s", e.Date, *e.Amount)
+	}
+	if e.CurrencyCode != nil && *e.CurrencyCode != market.CurrencyCode {
+		amount, err = s.CurrenciesService.ConvertCurrencyAmount(
+			amount, *e.CurrencyCode, market.CurrencyCode, e.Date.Time())
+		if err != nil {
+			return nil, fmt.Errorf("dividend at %s: %w", e.Date, err)
+		}
+	}
+
+	f := decimal.NewFromInt(1).Sub(amount.Div(closePrice))
+	if !f.IsPositive() {
+		return nil, fmt.Errorf("dividend at %s exceeds previous close", e.Date)
+	}
+	return &f, nil
+}
+
+// parseSplitRatio parses ratio n:m (n new shares for m old shares) or n (n:1)
+// and returns n/m
+func parseSplitRatio(ratio string) (decimal.Decimal, error) {
+	newShares, oldShares, found := strings.Cut(ratio, ":")
+	if !found {
+		oldShares = "1"
+	}
+
+	n, err := decimal.NewFromString(strings.TrimSpace(newShares))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid ratio %s", ratio)
+	}
+	m, err := decimal.NewFromString(strings.TrimSpace(oldShares))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid ratio %s", ratio)
+	}
+	if !n.IsPositive() || !m.IsPositive() {
+		return decimal.Zero, fmt.Errorf("invalid ratio %s", ratio)
+	}
+
+	return n.Div(m), nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSplitRatio(t *testing.T) {
+	a := assert.New(t)
+
+	for ratio, expected := range map[string]string{
+		"2:1":   "2",
+		"1:4":   "0.25",
+		"3 : 2": "1.5",
+		"10":    "10",
+	} {
+		r, err := parseSplitRatio(ratio)
+		a.Nil(err, ratio)
+		a.Equal(expected, r.String(), ratio)
+	}
+
+	for _, ratio := range []string{"", "a:1", "2:", "0:1", "-1:2"} {
+		_, err := parseSplitRatio(ratio)
+		a.NotNil(err, ratio)
+	}
+}
+
+func TestPriceAdjustmentsCache(t *testing.T) {
+	a := assert.New(t)
+
+	s := &priceService{adjustments: map[string]*cachedPriceAdjustments{}}
+	now := time.Now()
+	factors := []*model.PriceAdjustmentFactor{}
+
+	s.cachePriceAdjustmentFactors("a", "XFRA/split", factors, now)
+	_, ok := s.cachedPriceAdjustmentFactors("a", "XFRA/split", now)
+	a.True(ok)
+	_, ok = s.cachedPriceAdjustmentFactors("a", "XFRA/total", now)
+	a.False(ok)
+
+	// Entries expire
+	_, ok = s.cachedPriceAdjustmentFactors("a", "XFRA/split", now.Add(priceAdjustmentsTTL))
+	a.False(ok)
+
+	s.InvalidatePriceAdjustments("a")
+	_, ok = s.cachedPriceAdjustmentFactors("a", "XFRA/split", now)
+	a.False(ok)
+
+	// Number of securities is bounded, expired entries are removed first
+	s.cachePriceAdjustmentFactors("expired", "XFRA/split", factors, now.Add(-priceAdjustmentsTTL))
+	for i := 1; i < maxCachedPriceAdjustments; i++ {
+		s.cachePriceAdjustmentFactors(strconv.Itoa(i), "XFRA/split", factors, now)
+	}
+	a.Len(s.adjustments, maxCachedPriceAdjustments)
+	s.cachePriceAdjustmentFactors("new", "XFRA/split", factors, now)
+	a.Len(s.adjustments, maxCachedPriceAdjustments)
+	a.NotContains(s.adjustments, "expired")
+
+	s.cachePriceAdjustmentFactors("newer", "XFRA/split", factors, now)
+	a.Len(s.adjustments, maxCachedPriceAdjustments)
+	_, ok = s.cachedPriceAdjustmentFactors("newer", "XFRA/split", now)
+	a.True(ok)
+}
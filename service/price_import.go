@@ -0,0 +1,345 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// priceImportBatchSize is number of prices upserted at once
+	priceImportBatchSize = 1000
+	// maxPriceImportErrors limits number of errors listed in result
+	maxPriceImportErrors = 100
+	// maxPriceImportLineLength limits length of line in NDJSON
+	maxPriceImportLineLength = 1024 * 1024
+)
+
+// maxPrice is the exclusive upper limit of prices, see securities_markets_prices DECIMAL(10,4)
+var maxPrice = decimal.NewFromInt(1000000)
+
+// checkPriceValue validates value of price against securities_markets_prices DECIMAL(10,4)
+func checkPriceValue(name string, value decimal.Decimal) error {
+	if value.IsNegative() || !value.LessThan(maxPrice) {
+		return fmt.Errorf("%s %s is out of range", name, value)
+	}
+	if !value.Equal(value.Round(4)) {
+		return fmt.Errorf("%s %s has more than 4 decimal places", name, value)
+	}
+	return nil
+}
+
+// priceImportRow is one price of security market in bulk import
+type priceImportRow struct {
+	Line         int              `json:"-"`
+	SecurityUUID string           `json:"securityUuid"`
+	MarketCode   string           `json:"marketCode"`
+	Date         string           `json:"date"`
+	Close        *decimal.Decimal `json:"close"`
+	Open         *decimal.Decimal `json:"open"`
+	High         *decimal.Decimal `json:"high"`
+	Low          *decimal.Decimal `json:"low"`
+	Volume       *int64           `json:"volume"`
+}
+
+// priceImport holds state of one bulk import of prices
+type priceImport struct {
+	DB     *gorm.DB
+	result *model.PriceImportResult
+
+	// Security markets by securityUuid/marketCode, nil if not existing
+	markets map[string]*db.SecurityMarket
+	// IDs of security markets with imported prices
+	touched map[uint]*db.SecurityMarket
+
+	// Prices of current batch, later rows replace earlier rows of same market and date
+	batch        []db.SecurityMarketPrice
+	batchIndexes map[string]int
+}
+
+// ImportPrices reads prices of many security markets as stream and upserts them
+// in batches, invalid rows are skipped and reported in result.
+// Open, high, low and volume of existing prices are kept if not given.
+// If stream cannot be read to its end, prices read so far are kept and
+// result is returned together with error.
+func (s *priceService) ImportPrices(r io.Reader, format model.PriceImportFormat) (*model.PriceImportResult, error) {
+	imp := &priceImport{
+		DB:           s.DB,
+		result:       &model.PriceImportResult{Errors: []*model.PriceImportError{}},
+		markets:      map[string]*db.SecurityMarket{},
+		touched:      map[uint]*db.SecurityMarket{},
+		batchIndexes: map[string]int{},
+	}
+
+	var readErr error
+	switch format {
+	case model.PriceImportFormatCSV:
+		readErr = readPriceImportCSV(r, imp.add, imp.addError)
+	case model.PriceImportFormatNDJSON:
+		readErr = readPriceImportNDJSON(r, imp.add, imp.addError)
+	default:
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+
+	// Batches may have been upserted before stream failed
+	imp.flush()
+
+	// Keep firstPriceDate and lastPriceDate up-to-date, once per market
+	for id, m := range imp.touched {
+		if err := updatePriceDates(s.DB, id); err != nil {
+			panic(err)
+		}
+		s.InvalidatePriceAdjustments(m.SecurityUUID)
+	}
+	imp.result.Markets = len(imp.touched)
+
+	if imp.result.Imported > 0 {
+		go s.evaluateAlerts()
+	}
+
+	return imp.result, readErr
+}
+
+// addError records error of row
+func (imp *priceImport) addError(line int, err error) {
+	imp.result.ErrorCount++
+	if len(imp.result.Errors) < maxPriceImportErrors {
+		imp.result.Errors = append(imp.result.Errors, &model.PriceImportError{
+			Line:  line,
+			Error: err.Error(),
+		})
+	}
+}
+
+// add validates row and adds it to current batch
+func (imp *priceImport) add(row *priceImportRow) {
+	price, market, err := imp.priceFromRow(row)
+	if err != nil {
+		imp.addError(row.Line, err)
+		return
+	}
+
+	key := fmt.Sprintf("%d/%s", price.SecurityMarketID, price.Date)
+	if i, ok := imp.batchIndexes[key]; ok {
+		imp.batch[i] = *price
+	} else {
+		imp.batchIndexes[key] = len(imp.batch)
+		imp.batch = append(imp.batch, *price)
+		imp.result.Imported++
+	}
+	imp.touched[market.ID] = market
+
+	if len(imp.batch) >= priceImportBatchSize {
+		imp.flush()
+	}
+}
+
+// flush upserts prices of current batch
+func (imp *priceImport) flush() {
+	if len(imp.batch) == 0 {
+		return
+	}
+
+	onConflict := clause.OnConflict{
+		Columns: []clause.Column{{Name: "security_market_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"close":  gorm.Expr("EXCLUDED.close"),
+			"open":   gorm.Expr("COALESCE(EXCLUDED.open, securities_markets_prices.open)"),
+			"high":   gorm.Expr("COALESCE(EXCLUDED.high, securities_markets_prices.high)"),
+			"low":    gorm.Expr("COALESCE(EXCLUDED.low, securities_markets_prices.low)"),
+			"volume": gorm.Expr("COALESCE(EXCLUDED.volume, securities_markets_prices.volume)"),
+		}),
+	}
+	if err := imp.DB.Clauses(onConflict).Create(&imp.batch).Error; err != nil {
+		panic(err)
+	}
+
+	imp.batch = imp.batch[:0]
+	imp.batchIndexes = map[string]int{}
+}
+
+// priceFromRow validates row and converts it into price for database
+func (imp *priceImport) priceFromRow(row *priceImportRow) (*db.SecurityMarketPrice, *db.SecurityMarket, error) {
+	securityUuid, err := uuid.Parse(row.SecurityUUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid securityUuid %q", row.SecurityUUID)
+	}
+	date, err := time.Parse("2006-01-02", row.Date)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid date %q", row.Date)
+	}
+	if row.Close == nil {
+		return nil, nil, errors.New("close is missing")
+	}
+	for name, value := range map[string]*decimal.Decimal{
+		"close": row.Close, "open": row.Open, "high": row.High, "low": row.Low,
+	} {
+		if value == nil {
+			continue
+		}
+		if err := checkPriceValue(name, *value); err != nil {
+			return nil, nil, err
+		}
+	}
+	if row.Volume != nil && *row.Volume < 0 {
+		return nil, nil, fmt.Errorf("volume %d is negative", *row.Volume)
+	}
+
+	market, err := imp.securityMarket(securityUuid, row.MarketCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &db.SecurityMarketPrice{
+		SecurityMarketID: market.ID,
+		Date:             model.Date(date),
+		Close:            db.DecimalString(row.Close.String()),
+		Open:             db.DecimalStringFromDecimal(row.Open),
+		High:             db.DecimalStringFromDecimal(row.High),
+		Low:              db.DecimalStringFromDecimal(row.Low),
+		Volume:           row.Volume,
+	}, market, nil
+}
+
+// securityMarket returns existing market of security, results are cached during import
+func (imp *priceImport) securityMarket(securityUuid uuid.UUID, marketCode string) (*db.SecurityMarket, error) {
+	key := securityUuid.String() + "/" + marketCode
+
+	market, ok := imp.markets[key]
+	if !ok {
+		var m db.SecurityMarket
+		err := imp.DB.Take(&m, "security_uuid = ? AND market_code = ?", securityUuid, marketCode).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			panic(err)
+		}
+		if err == nil {
+			market = &m
+		}
+		imp.markets[key] = market
+	}
+
+	if market == nil {
+		return nil, fmt.Errorf("market %s of security %s does not exist", marketCode, securityUuid)
+	}
+	return market, nil
+}
+
+// readPriceImportCSV reads CSV with header, columns may be in any order
+func readPriceImportCSV(r io.Reader, add func(*priceImportRow), addError func(int, error)) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot read header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"securityUuid", "marketCode", "date", "close"} {
+		if _, ok := columns[name]; !ok {
+			return fmt.Errorf("column %s is missing", name)
+		}
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			addError(parseErr.StartLine, parseErr.Err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) != len(header) {
+			addError(line, fmt.Errorf("expected %d fields, got %d", len(header), len(record)))
+			continue
+		}
+
+		row := &priceImportRow{
+			Line:         line,
+			SecurityUUID: record[columns["securityUuid"]],
+			MarketCode:   record[columns["marketCode"]],
+			Date:         record[columns["date"]],
+		}
+		if err := row.parseCSVValues(record, columns); err != nil {
+			addError(line, err)
+			continue
+		}
+		add(row)
+	}
+}
+
+// parseCSVValues parses decimals and volume of CSV record, empty values are nil
+func (row *priceImportRow) parseCSVValues(record []string, columns map[string]int) error {
+	for name, target := range map[string]**decimal.Decimal{
+		"close": &row.Close, "open": &row.Open, "high": &row.High, "low": &row.Low,
+	} {
+		i, ok := columns[name]
+		if !ok || record[i] == "" {
+			continue
+		}
+		d, err := decimal.NewFromString(record[i])
+		if err != nil {
+			return fmt.Errorf("invalid %s %q", name, record[i])
+		}
+		*target = &d
+	}
+
+	if i, ok := columns["volume"]; ok && record[i] != "" {
+		volume, err := strconv.ParseInt(record[i], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid volume %q", record[i])
+		}
+		row.Volume = &volume
+	}
+	return nil
+}
+
+// readPriceImportNDJSON reads one JSON object per line, empty lines are skipped
+func readPriceImportNDJSON(r io.Reader, add func(*priceImportRow), addError func(int, error)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxPriceImportLineLength)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		row := &priceImportRow{}
+		if err := json.Unmarshal([]byte(text), row); err != nil {
+			addError(line, err)
+			continue
+		}
+		row.Line = line
+		add(row)
+	}
+	return scanner.Err()
+}
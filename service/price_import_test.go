@@ -0,0 +1,61 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPriceImportCSV(t *testing.T) {
+	a := assert.New(t)
+
+	input := "marketCode,securityUuid,date,close,volume\n" +
+		"XFRA,7c8b8b9e-0f7c-4b8a-9f1e-3d0c5e2b1a01,2022-01-03,1.23456789,100\n" +
+		"XFRA,7c8b8b9e-0f7c-4b8a-9f1e-3d0c5e2b1a01,2022-01-04,abc,\n" +
+		"XFRA,7c8b8b9e-0f7c-4b8a-9f1e-3d0c5e2b1a01\n" +
+		"XNAS,7c8b8b9e-0f7c-4b8a-9f1e-3d0c5e2b1a01,2022-01-05,2,\n"
+
+	var rows []*priceImportRow
+	errLines := []int{}
+	err := readPriceImportCSV(strings.NewReader(input),
+		func(row *priceImportRow) { rows = append(rows, row) },
+		func(line int, err error) { errLines = append(errLines, line) })
+	a.Nil(err)
+
+	a.Len(rows, 2)
+	a.Equal(2, rows[0].Line)
+	a.Equal("XFRA", rows[0].MarketCode)
+	a.Equal("1.23456789", rows[0].Close.String())
+	a.Equal(int64(100), *rows[0].Volume)
+	a.Nil(rows[0].Open)
+	a.Equal("XNAS", rows[1].MarketCode)
+	a.Nil(rows[1].Volume)
+	a.Equal([]int{3, 4}, errLines)
+
+	err = readPriceImportCSV(strings.NewReader("date,close\n"), nil, nil)
+	a.ErrorContains(err, "column securityUuid is missing")
+}
+
+func TestReadPriceImportNDJSON(t *testing.T) {
+	a := assert.New(t)
+
+	input := `{"securityUuid":"7c8b8b9e-0f7c-4b8a-9f1e-3d0c5e2b1a01","marketCode":"XFRA","date":"2022-01-03","close":0.1000000001}` + "\n" +
+		"\n" +
+		`{"securityUuid":"7c8b8b9e-0f7c-4b8a-9f1e-3d0c5e2b1a01","marketCode":"XFRA","date":"2022-01-04","close":"2.5","open":2}` + "\n" +
+		`{"close": [1]}` + "\n"
+
+	var rows []*priceImportRow
+	errLines := []int{}
+	err := readPriceImportNDJSON(strings.NewReader(input),
+		func(row *priceImportRow) { rows = append(rows, row) },
+		func(line int, err error) { errLines = append(errLines, line) })
+	a.Nil(err)
+
+	a.Len(rows, 2)
+	a.Equal("0.1000000001", rows[0].Close.String())
+	a.Equal(3, rows[1].Line)
+	a.Equal("2.5", rows[1].Close.String())
+	a.Equal("2", rows[1].Open.String())
+	a.Equal([]int{4}, errLines)
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+type filePriceProvider struct {
+	Dir string
+}
+
+// NewFilePriceProvider creates price provider reading CSV files with header
+// (date,close and optionally open,high,low,volume in any order) from
+// <dir>/<marketCode>/<symbol>.csv or <dir>/<marketCode>/<securityUuid>.csv,
+// e.g. for local testing
+func NewFilePriceProvider(dir string) model.PriceProvider {
+	return &filePriceProvider{Dir: dir}
+}
+
+// Name returns name of provider
+func (p *filePriceProvider) Name() string {
+	return "file"
+}
+
+// GetPrices reads prices from file of security market
+func (p *filePriceProvider) GetPrices(ctx context.Context, req *model.PriceRequest) ([]*model.SecurityPrice, error) {
+	candidates := []string{}
+	if req.Symbol != nil {
+		candidates = append(candidates, *req.Symbol)
+	}
+	candidates = append(candidates, req.SecurityUUID.String())
+
+	for _, name := range candidates {
+		f, err := os.Open(filepath.Join(p.Dir, filepath.Base(req.MarketCode), filepath.Base(name)+".csv"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return p.parse(f, req.From)
+	}
+
+	return nil, fmt.Errorf("no price file found for %s", req.SecurityUUID)
+}
+
+// parse reads prices from CSV with header, prices before from are skipped
+func (*filePriceProvider) parse(r io.Reader, from *model.Date) ([]*model.SecurityPrice, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(name)] = i
+	}
+	for _, name := range []string{"date", "close"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("column %s is missing", name)
+		}
+	}
+
+	// optionalDecimal parses value of optional column, empty values are nil
+	optionalDecimal := func(record []string, name string) (*decimal.Decimal, error) {
+		i, ok := columns[name]
+		if !ok || record[i] == "" {
+			return nil, nil
+		}
+		d, err := decimal.NewFromString(record[i])
+		return &d, err
+	}
+
+	prices := []*model.SecurityPrice{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		date, err := time.Parse("2006-01-02", record[columns["date"]])
+		if err != nil {
+			return nil, fmt.Errorf("invalid date in line %d: %w", line, err)
+		}
+		if from != nil && date.Before(from.Time()) {
+			continue
+		}
+
+		price := &model.SecurityPrice{Date: model.Date(date)}
+		if price.Close, err = decimal.NewFromString(record[columns["close"]]); err != nil {
+			return nil, fmt.Errorf("invalid close in line %d: %w", line, err)
+		}
+		for name, target := range map[string]**decimal.Decimal{
+			"open": &price.Open,
+			"high": &price.High,
+			"low":  &price.Low,
+		} {
+			if *target, err = optionalDecimal(record, name); err != nil {
+				return nil, fmt.Errorf("invalid %s in line %d: %w", name, line, err)
+			}
+		}
+		if i, ok := columns["volume"]; ok && record[i] != "" {
+			volume, err := strconv.ParseInt(record[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid volume in line %d: %w", line, err)
+			}
+			price.Volume = &volume
+		}
+
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
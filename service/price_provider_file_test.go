@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePriceProvider(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.Mkdir(filepath.Join(dir, "XTST"), 0o755))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "XTST", "TST.csv"),
+		[]byte("date,close\n2022-01-03,1.23456789\n2022-01-04, 2\n2022-01-05,3.5\n"), 0o644))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "XTST", "INVALID.csv"),
+		[]byte("date,close\n2022-01-03,abc\n"), 0o644))
+
+	provider := NewFilePriceProvider(dir)
+	assert.Equal(t, "file", provider.Name())
+
+	symbol := "TST"
+	from := model.Date(time.Date(2022, 1, 4, 0, 0, 0, 0, time.UTC))
+	prices, err := provider.GetPrices(context.Background(), &model.PriceRequest{
+		SecurityUUID: uuid.New(),
+		MarketCode:   "XTST",
+		Symbol:       &symbol,
+		From:         &from,
+	})
+	require.Nil(t, err)
+	require.Len(t, prices, 2)
+	assert.Equal(t, "2022-01-04", prices[0].Date.String())
+	assert.Equal(t, "2", prices[0].Close.String())
+	assert.Equal(t, "3.5", prices[1].Close.String())
+
+	prices, err = provider.GetPrices(context.Background(), &model.PriceRequest{
+		SecurityUUID: uuid.New(),
+		MarketCode:   "XTST",
+		Symbol:       &symbol,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "1.23456789", prices[0].Close.String())
+
+	symbol = "INVALID"
+	_, err = provider.GetPrices(context.Background(), &model.PriceRequest{
+		SecurityUUID: uuid.New(),
+		MarketCode:   "XTST",
+		Symbol:       &symbol,
+	})
+	assert.ErrorContains(t, err, "invalid close in line 2")
+
+	_, err = provider.GetPrices(context.Background(), &model.PriceRequest{
+		SecurityUUID: uuid.New(),
+		MarketCode:   "XTST",
+	})
+	assert.ErrorContains(t, err, "no price file found")
+}
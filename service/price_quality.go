@@ -0,0 +1,368 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs/calendar"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultPriceCheckOptions are thresholds of price data quality checks run by cron
+var DefaultPriceCheckOptions = model.PriceCheckOptions{
+	JumpThreshold: decimal.NewFromFloat(0.5),
+	StaleDays:     5,
+	PlateauDays:   10,
+}
+
+// priceFindingKey identifies finding, each finding is stored only once
+type priceFindingKey struct {
+	SecurityMarketID uint
+	Type             model.PriceFindingType
+	Date             string
+}
+
+// CheckPriceQuality scans prices of all security markets for suspicious data and
+// stores findings. Findings detected again are kept, open findings which are not
+// detected anymore are removed. Acknowledged findings are kept in any case.
+func (s *priceService) CheckPriceQuality(options *model.PriceCheckOptions) (*model.PriceCheckResult, error) {
+	if options == nil {
+		options = &DefaultPriceCheckOptions
+	}
+	if !options.JumpThreshold.IsPositive() {
+		return nil, errors.New("jumpThreshold must be positive")
+	}
+	if options.StaleDays < 1 || options.PlateauDays < 2 {
+		return nil, errors.New("staleDays must be at least 1 and plateauDays at least 2")
+	}
+
+	log.Println("Checking quality of security prices...")
+
+	now := time.Now()
+	findings := []db.PriceFinding{}
+	result := &model.PriceCheckResult{Detected: map[model.PriceFindingType]int{}}
+	add := func(f db.PriceFinding) {
+		f.DetectedAt = now
+		findings = append(findings, f)
+		result.Detected[f.Type]++
+	}
+
+	for _, f := range s.findPriceJumps(options.JumpThreshold) {
+		add(f)
+	}
+	for _, f := range s.findNonPositivePrices() {
+		add(f)
+	}
+	for _, f := range s.findStalePrices(options.StaleDays, now) {
+		add(f)
+	}
+	for _, f := range s.findPricePlateaus(options.PlateauDays) {
+		add(f)
+	}
+
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		var existing []db.PriceFinding
+		if err := tx.Find(&existing).Error; err != nil {
+			return err
+		}
+		open := map[priceFindingKey]uint{}
+		known := map[priceFindingKey]bool{}
+		for _, f := range existing {
+			key := priceFindingKey{f.SecurityMarketID, f.Type, f.Date.String()}
+			known[key] = true
+			if f.AcknowledgedAt == nil {
+				open[key] = f.ID
+			}
+		}
+
+		for _, f := range findings {
+			key := priceFindingKey{f.SecurityMarketID, f.Type, f.Date.String()}
+			if !known[key] {
+				result.New++
+			}
+			delete(open, key)
+		}
+
+		if len(findings) > 0 {
+			onConflict := clause.OnConflict{
+				Columns:   []clause.Column{{Name: "security_market_id"}, {Name: "type"}, {Name: "date"}},
+				DoUpdates: clause.AssignmentColumns([]string{"details", "detected_at"}),
+			}
+			if err := tx.Clauses(onConflict).CreateInBatches(&findings, priceImportBatchSize).Error; err != nil {
+				return err
+			}
+		}
+
+		resolved := make([]uint, 0, len(open))
+		for _, id := range open {
+			resolved = append(resolved, id)
+		}
+		if len(resolved) > 0 {
+			if err := tx.Delete(&db.PriceFinding{}, resolved).Error; err != nil {
+				return err
+			}
+		}
+		result.Resolved = len(resolved)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	log.Printf("Checked quality of security prices, %d findings, %d new, %d resolved.",
+		len(findings), result.New, result.Resolved)
+
+	return result, nil
+}
+
+// findPriceJumps finds closes which changed beyond threshold compared to
+// previous close, unless there is a split between both dates
+func (s *priceService) findPriceJumps(threshold decimal.Decimal) []db.PriceFinding {
+	var jumps []struct {
+		SecurityMarketID uint
+		Date             model.Date
+		Close            decimal.Decimal
+		PrevClose        decimal.Decimal
+	}
+	factor := threshold.Add(decimal.NewFromInt(1))
+	if err := s.DB.Table(`(SELECT
+			security_market_id,
+			date,
+			close,
+			LAG(date, 1) OVER w AS prev_date,
+			LAG(close, 1) OVER w AS prev_close
+		FROM securities_markets_prices
+		WINDOW w AS (PARTITION BY security_market_id ORDER BY date)) p`).
+		Select("p.security_market_id, p.date, p.close, p.prev_close").
+		Joins("INNER JOIN securities_markets m ON m.id = p.security_market_id").
+		Where("p.close > 0 AND p.prev_close > 0").
+		Where("(p.close > p.prev_close * ? OR p.prev_close > p.close * ?)", factor, factor).
+		Where(`NOT EXISTS (SELECT 1 FROM events e
+			WHERE e.security_uuid = m.security_uuid AND e.type = 'split'
+			AND e.date > p.prev_date AND e.date <= p.date)`).
+		Order("p.security_market_id, p.date").
+		Scan(&jumps).Error; err != nil {
+		panic(err)
+	}
+
+	findings := make([]db.PriceFinding, len(jumps))
+	for i, j := range jumps {
+		findings[i] = db.PriceFinding{
+			SecurityMarketID: j.SecurityMarketID,
+			Type:             model.PriceFindingTypeJump,
+			Date:             j.Date,
+			Details:          describePriceJump(j.PrevClose, j.Close),
+		}
+	}
+	return findings
+}
+
+// describePriceJump describes change of previous close to close
+func describePriceJump(prevClose, closePrice decimal.Decimal) string {
+	change := closePrice.Div(prevClose).Sub(decimal.NewFromInt(1)).Mul(decimal.NewFromInt(100))
+	sign := ""
+	if change.IsPositive() {
+		sign = "+"
+	}
+	return fmt.Sprintf("close changed from %s to %s (%s%s%%) without split",
+		prevClose, closePrice, sign, change.StringFixed(1))
+}
+
+// findNonPositivePrices finds closes of zero or less
+func (s *priceService) findNonPositivePrices() []db.PriceFinding {
+	var prices []db.SecurityMarketPrice
+	if err := s.DB.
+		Where("close <= 0").
+		Order("security_market_id, date").
+		Find(&prices).Error; err != nil {
+		panic(err)
+	}
+
+	findings := make([]db.PriceFinding, len(prices))
+	for i, p := range prices {
+		findings[i] = db.PriceFinding{
+			SecurityMarketID: p.SecurityMarketID,
+			Type:             model.PriceFindingTypeNonPositive,
+			Date:             p.Date,
+			Details:          fmt.Sprintf("close is %s", p.Close),
+		}
+	}
+	return findings
+}
+
+// findStalePrices finds security markets with updatePrices whose last price is
+// older than staleDays trading days, finding is dated at last price date
+func (s *priceService) findStalePrices(staleDays int, now time.Time) []db.PriceFinding {
+	var markets []db.SecurityMarket
+	if err := s.DB.
+		Where("update_prices AND last_price_date IS NOT NULL").
+		Order("id").
+		Find(&markets).Error; err != nil {
+		panic(err)
+	}
+
+	calendars := marketCalendars(s.DB)
+
+	findings := []db.PriceFinding{}
+	for _, m := range markets {
+		cal, ok := calendars[m.MarketCode]
+		if !ok {
+			cal = calendar.ForMarket(m.MarketCode)
+		}
+		missing := missingTradingDays(cal, m.LastPriceDate.Time(), now)
+		if missing <= staleDays {
+			continue
+		}
+		findings = append(findings, db.PriceFinding{
+			SecurityMarketID: m.ID,
+			Type:             model.PriceFindingTypeStale,
+			Date:             *m.LastPriceDate,
+			Details:          fmt.Sprintf("no prices for %d trading days", missing),
+		})
+	}
+	return findings
+}
+
+// missingTradingDays counts trading days after last price date until day
+// before now, prices of the current day are not expected yet
+func missingTradingDays(cal *calendar.Calendar, lastPriceDate, now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return cal.CountTradingDays(lastPriceDate.AddDate(0, 0, 1), today.AddDate(0, 0, -1))
+}
+
+// findPricePlateaus finds at least plateauDays consecutive prices with identical
+// close, finding is dated at first price of plateau
+func (s *priceService) findPricePlateaus(plateauDays int) []db.PriceFinding {
+	var plateaus []struct {
+		SecurityMarketID uint
+		FromDate         model.Date
+		ToDate           model.Date
+		Count            int
+		Close            decimal.Decimal
+	}
+	// Consecutive prices with same close have the same difference of row numbers
+	if err := s.DB.Table(`(SELECT
+			security_market_id,
+			date,
+			close,
+			ROW_NUMBER() OVER (PARTITION BY security_market_id ORDER BY date)
+				- ROW_NUMBER() OVER (PARTITION BY security_market_id, close ORDER BY date) AS grp
+		FROM securities_markets_prices) p`).
+		Select(`p.security_market_id, MIN(p.date) AS from_date, MAX(p.date) AS to_date,
+			COUNT(*) AS count, p.close`).
+		Group("p.security_market_id, p.close, p.grp").
+		Having("COUNT(*) >= ?", plateauDays).
+		Order("p.security_market_id, from_date").
+		Scan(&plateaus).Error; err != nil {
+		panic(err)
+	}
+
+	findings := make([]db.PriceFinding, len(plateaus))
+	for i, p := range plateaus {
+		findings[i] = db.PriceFinding{
+			SecurityMarketID: p.SecurityMarketID,
+			Type:             model.PriceFindingTypePlateau,
+			Date:             p.FromDate,
+			Details: fmt.Sprintf("close %s unchanged for %d prices until %s",
+				p.Close, p.Count, p.ToDate),
+		}
+	}
+	return findings
+}
+
+// priceFindingsQuery returns query of findings with security market and user
+func (s *priceService) priceFindingsQuery() *gorm.DB {
+	return s.DB.Table("price_findings f").
+		Select(`f.id, m.security_uuid, m.market_code, f.type, f.date, f.details,
+			f.created_at, f.detected_at, f.acknowledged_at, u.username AS acknowledged_by, f.note`).
+		Joins("INNER JOIN securities_markets m ON m.id = f.security_market_id").
+		Joins("LEFT JOIN users u ON u.id = f.acknowledged_by_user_id")
+}
+
+// GetPriceFindings lists findings of price data quality checks, newest first
+func (s *priceService) GetPriceFindings(q *model.PriceFindingsQuery) *model.PriceFindings {
+	query := s.priceFindingsQuery()
+
+	if q.Type != "" {
+		query = query.Where("f.type = ?", q.Type)
+	}
+	if q.MarketCode != "" {
+		query = query.Where("m.market_code = ?", q.MarketCode)
+	}
+	if q.SecurityUUID != nil {
+		query = query.Where("m.security_uuid = ?", *q.SecurityUUID)
+	}
+	if q.Acknowledged != nil {
+		if *q.Acknowledged {
+			query = query.Where("f.acknowledged_at IS NOT NULL")
+		} else {
+			query = query.Where("f.acknowledged_at IS NULL")
+		}
+	}
+
+	var count int64
+	if err := query.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		panic(err)
+	}
+
+	entries := []*model.PriceFinding{}
+	if err := query.
+		Order("f.date DESC, f.id DESC").
+		Limit(q.Limit).Offset(q.Skip).
+		Scan(&entries).Error; err != nil {
+		panic(err)
+	}
+
+	return &model.PriceFindings{Entries: entries, TotalCount: int(count)}
+}
+
+// getPriceFinding returns finding by ID
+func (s *priceService) getPriceFinding(id uint) (*model.PriceFinding, error) {
+	var finding model.PriceFinding
+	result := s.priceFindingsQuery().Where("f.id = ?", id).Limit(1).Scan(&finding)
+	if result.Error != nil {
+		panic(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+	return &finding, nil
+}
+
+// AcknowledgePriceFinding marks finding as acknowledged by user, optionally with note.
+// Acknowledged findings are kept even if they are not detected anymore.
+func (s *priceService) AcknowledgePriceFinding(id uint, user *model.User, note *string) (*model.PriceFinding, error) {
+	userID := uint(user.ID)
+	return s.updatePriceFinding(id, map[string]interface{}{
+		"acknowledged_at":         time.Now(),
+		"acknowledged_by_user_id": &userID,
+		"note":                    note,
+	})
+}
+
+// UnacknowledgePriceFinding reopens acknowledged finding
+func (s *priceService) UnacknowledgePriceFinding(id uint) (*model.PriceFinding, error) {
+	return s.updatePriceFinding(id, map[string]interface{}{
+		"acknowledged_at":         nil,
+		"acknowledged_by_user_id": nil,
+		"note":                    nil,
+	})
+}
+
+// updatePriceFinding updates columns of finding and returns it
+func (s *priceService) updatePriceFinding(id uint, values map[string]interface{}) (*model.PriceFinding, error) {
+	result := s.DB.Model(&db.PriceFinding{}).Where("id = ?", id).Updates(values)
+	if result.Error != nil {
+		panic(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+	return s.getPriceFinding(id)
+}
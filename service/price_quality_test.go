@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/portfolio-report/pr-api/libs/calendar"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribePriceJump(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("close changed from 10 to 25 (+150.0%) without split",
+		describePriceJump(decimal.NewFromInt(10), decimal.NewFromInt(25)))
+	a.Equal("close changed from 30 to 10 (-66.7%) without split",
+		describePriceJump(decimal.NewFromInt(30), decimal.NewFromInt(10)))
+}
+
+func TestMissingTradingDays(t *testing.T) {
+	a := assert.New(t)
+	cal := calendar.Default()
+
+	// Friday 2022-06-10, now Monday 2022-06-13 => no trading day missing yet
+	a.Equal(0, missingTradingDays(cal,
+		time.Date(2022, 6, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 6, 13, 18, 0, 0, 0, time.UTC)))
+
+	// Friday 2022-06-10, now Monday 2022-06-20 => Monday until Friday missing
+	a.Equal(5, missingTradingDays(cal,
+		time.Date(2022, 6, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 6, 20, 8, 0, 0, 0, time.UTC)))
+
+	// Last price today
+	a.Equal(0, missingTradingDays(cal,
+		time.Date(2022, 6, 13, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 6, 13, 8, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// priceProviderTimeout limits duration of retrieving prices of one security market
+const priceProviderTimeout = time.Minute
+
+type priceService struct {
+	DB                *gorm.DB
+	CurrenciesService model.CurrenciesService
+	AlertService      model.AlertService
+
+	mu        sync.RWMutex
+	providers map[string]model.PriceProvider
+
+	// Held while prices are updated
+	updating sync.Mutex
+
+	// Cached adjustment factors by security UUID
+	adjustmentsMu sync.Mutex
+	adjustments   map[string]*cachedPriceAdjustments
+}
+
+// NewPriceService creates and returns new price service, alerts are
+// evaluated by alertService whenever new prices arrive
+func NewPriceService(db *gorm.DB, currenciesService model.CurrenciesService, alertService model.AlertService) model.PriceService {
+	return &priceService{
+		DB:                db,
+		CurrenciesService: currenciesService,
+		AlertService:      alertService,
+		providers:         map[string]model.PriceProvider{},
+		adjustments:       map[string]*cachedPriceAdjustments{},
+	}
+}
+
+// RegisterPriceProvider sets provider of prices for market, replaces previous provider
+func (s *priceService) RegisterPriceProvider(marketCode string, provider model.PriceProvider) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.providers[marketCode] = provider
+}
+
+// GetPriceProvider returns provider of prices for market
+func (s *priceService) GetPriceProvider(marketCode string) (model.PriceProvider, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	provider, ok := s.providers[marketCode]
+	return provider, ok
+}
+
+// ErrPriceUpdateRunning is returned if prices are updated already
+var ErrPriceUpdateRunning = errors.New("price update is already running")
+
+// UpdatePrices retrieves new prices for all security markets with updatePrices
+// from their price provider, result of each update is stored with security market.
+// Only one update runs at a time, otherwise ErrPriceUpdateRunning is returned.
+func (s *priceService) UpdatePrices() error {
+	if !s.updating.TryLock() {
+		return ErrPriceUpdateRunning
+	}
+	defer s.updating.Unlock()
+
+	return s.updatePrices()
+}
+
+// StartPriceUpdate starts UpdatePrices in background, returns ErrPriceUpdateRunning
+// if prices are updated already. Results are stored with security markets.
+func (s *priceService) StartPriceUpdate() error {
+	if !s.updating.TryLock() {
+		return ErrPriceUpdateRunning
+	}
+
+	go func() {
+		defer s.updating.Unlock()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Println("Panic while updating security prices:", r,
+					"\nstacktrace:\n"+string(debug.Stack()))
+			}
+		}()
+
+		if err := s.updatePrices(); err != nil {
+			log.Println("Error while updating security prices:", err)
+		}
+	}()
+	return nil
+}
+
+// updatePrices updates prices of all security markets, see UpdatePrices
+func (s *priceService) updatePrices() error {
+	log.Println("Updating security prices...")
+
+	var markets []struct {
+		db.SecurityMarket
+		Isin *string
+	}
+	if err := s.DB.
+		Table("securities_markets").
+		Select("securities_markets.*, securities.isin").
+		Joins("INNER JOIN securities ON securities.uuid = securities_markets.security_uuid").
+		Where("securities_markets.update_prices").
+		Order("securities_markets.id").
+		Find(&markets).Error; err != nil {
+		panic(err)
+	}
+
+	updated, failed := 0, 0
+	for _, m := range markets {
+		provider, ok := s.GetPriceProvider(m.MarketCode)
+		if !ok {
+			continue
+		}
+
+		count, err := s.updatePricesOfMarket(provider, &m.SecurityMarket, m.Isin)
+		if err != nil {
+			log.Printf("Updating prices of %s/%s from %s failed: %v\n",
+				m.SecurityUUID, m.MarketCode, provider.Name(), err)
+			failed++
+		} else if count > 0 {
+			updated++
+		}
+		s.recordPriceUpdate(m.ID, err)
+		if count > 0 {
+			s.InvalidatePriceAdjustments(m.SecurityUUID)
+		}
+	}
+
+	log.Printf("Updating security prices finished, %d updated, %d failed.\n", updated, failed)
+	if updated > 0 {
+		s.evaluateAlerts()
+	}
+	if failed > 0 {
+		return fmt.Errorf("updating prices of %d security market(s) failed", failed)
+	}
+	return nil
+}
+
+// evaluateAlerts evaluates alerts after new prices arrived, failures are only logged
+func (s *priceService) evaluateAlerts() {
+	if s.AlertService == nil {
+		return
+	}
+	if err := s.AlertService.EvaluateAlerts(); err != nil {
+		log.Println("Evaluating alerts failed:", err)
+	}
+}
+
+// updatePricesOfMarket retrieves prices after last price date and stores them,
+// returns number of stored prices
+func (s *priceService) updatePricesOfMarket(provider model.PriceProvider, m *db.SecurityMarket, isin *string) (int, error) {
+	securityUuid, err := uuid.Parse(m.SecurityUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	req := &model.PriceRequest{
+		SecurityUUID: securityUuid,
+		Isin:         isin,
+		MarketCode:   m.MarketCode,
+		Symbol:       m.Symbol,
+		CurrencyCode: m.CurrencyCode,
+	}
+	if m.LastPriceDate != nil {
+		from := model.Date(m.LastPriceDate.Time().AddDate(0, 0, 1))
+		req.From = &from
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), priceProviderTimeout)
+	defer cancel()
+
+	prices, err := provider.GetPrices(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	newPrices := []db.SecurityMarketPrice{}
+	for _, p := range prices {
+		if req.From != nil && p.Date.Time().Before(req.From.Time()) {
+			continue
+		}
+		if err := checkSecurityPrice(p); err != nil {
+			return 0, fmt.Errorf("invalid price at %s: %w", p.Date, err)
+		}
+		newPrices = append(newPrices, db.SecurityMarketPrice{
+			SecurityMarketID: m.ID,
+			Date:             p.Date,
+			Close:            db.DecimalString(p.Close.String()),
+			Open:             db.DecimalStringFromDecimal(p.Open),
+			High:             db.DecimalStringFromDecimal(p.High),
+			Low:              db.DecimalStringFromDecimal(p.Low),
+			Volume:           p.Volume,
+		})
+	}
+	if len(newPrices) == 0 {
+		return 0, nil
+	}
+
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).
+			CreateInBatches(&newPrices, 1000).Error; err != nil {
+			return err
+		}
+		return updatePriceDates(tx, m.ID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(newPrices), nil
+}
+
+// UpdateSecurityMarketPrices creates or updates prices of security market,
+// open, high, low and volume of existing prices are kept if not given.
+// Prices are validated before any of them is stored.
+func (s *priceService) UpdateSecurityMarketPrices(securityUUID uuid.UUID, marketCode string, prices []*model.SecurityPrice) error {
+	for _, p := range prices {
+		if err := checkSecurityPrice(p); err != nil {
+			return fmt.Errorf("invalid price at %s: %w", p.Date, err)
+		}
+	}
+
+	var market db.SecurityMarket
+	err := s.DB.Take(&market, "security_uuid = ? AND market_code = ?", securityUUID, marketCode).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.ErrNotFound
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	newPrices := []db.SecurityMarketPrice{}
+	for _, p := range prices {
+		newPrices = append(newPrices, db.SecurityMarketPrice{
+			SecurityMarketID: market.ID,
+			Date:             p.Date,
+			Close:            db.DecimalString(p.Close.String()),
+			Open:             db.DecimalStringFromDecimal(p.Open),
+			High:             db.DecimalStringFromDecimal(p.High),
+			Low:              db.DecimalStringFromDecimal(p.Low),
+			Volume:           p.Volume,
+		})
+	}
+
+	onConflict := clause.OnConflict{
+		Columns: []clause.Column{{Name: "security_market_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"close":  gorm.Expr("EXCLUDED.close"),
+			"open":   gorm.Expr("COALESCE(EXCLUDED.open, securities_markets_prices.open)"),
+			"high":   gorm.Expr("COALESCE(EXCLUDED.high, securities_markets_prices.high)"),
+			"low":    gorm.Expr("COALESCE(EXCLUDED.low, securities_markets_prices.low)"),
+			"volume": gorm.Expr("COALESCE(EXCLUDED.volume, securities_markets_prices.volume)"),
+		}),
+	}
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		if len(newPrices) > 0 {
+			if err := tx.Clauses(onConflict).CreateInBatches(&newPrices, 1000).Error; err != nil {
+				return err
+			}
+		}
+		return updatePriceDates(tx, market.ID)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	s.InvalidatePriceAdjustments(market.SecurityUUID)
+	return nil
+}
+
+// checkSecurityPrice validates price retrieved from price provider or given by client
+func checkSecurityPrice(p *model.SecurityPrice) error {
+	for name, value := range map[string]*decimal.Decimal{
+		"close": &p.Close, "open": p.Open, "high": p.High, "low": p.Low,
+	} {
+		if value == nil {
+			continue
+		}
+		if err := checkPriceValue(name, *value); err != nil {
+			return err
+		}
+	}
+	if p.Low != nil && p.High != nil && p.Low.GreaterThan(*p.High) {
+		return fmt.Errorf("low %s is greater than high %s", p.Low, p.High)
+	}
+	if p.Volume != nil && *p.Volume < 0 {
+		return fmt.Errorf("volume %d is negative", *p.Volume)
+	}
+	return nil
+}
+
+// recordPriceUpdate stores result of price update with security market
+func (s *priceService) recordPriceUpdate(securityMarketID uint, updateErr error) {
+	updates := map[string]interface{}{
+		"price_update_at":       time.Now(),
+		"price_update_error":    nil,
+		"price_update_failures": 0,
+	}
+	if updateErr != nil {
+		updates["price_update_error"] = updateErr.Error()
+		updates["price_update_failures"] = gorm.Expr("price_update_failures + 1")
+	}
+
+	if err := s.DB.Model(&db.SecurityMarket{}).
+		Where("id = ?", securityMarketID).
+		Updates(updates).Error; err != nil {
+		panic(err)
+	}
+}
+
+// GetPriceUpdateStatus lists result of last price update of security markets
+// with updatePrices, optionally only failed ones
+func (s *priceService) GetPriceUpdateStatus(onlyFailed bool) []*model.PriceUpdateStatus {
+	query := s.DB.Where("update_prices")
+	if onlyFailed {
+		query = query.Where("price_update_failures > 0")
+	}
+
+	var markets []db.SecurityMarket
+	if err := query.Order("price_update_failures DESC, security_uuid, market_code").Find(&markets).Error; err != nil {
+		panic(err)
+	}
+
+	ret := make([]*model.PriceUpdateStatus, len(markets))
+	for i, m := range markets {
+		ret[i] = &model.PriceUpdateStatus{
+			SecurityUUID:        uuid.MustParse(m.SecurityUUID),
+			MarketCode:          m.MarketCode,
+			LastPriceDate:       m.LastPriceDate,
+			PriceUpdateAt:       m.PriceUpdateAt,
+			PriceUpdateError:    m.PriceUpdateError,
+			PriceUpdateFailures: m.PriceUpdateFailures,
+		}
+	}
+	return ret
+}
+
+// updatePriceDates keeps firstPriceDate and lastPriceDate of security market up-to-date
+// and marks security market as updated since its prices changed
+func updatePriceDates(tx *gorm.DB, securityMarketID uint) error {
+	return tx.Exec(`UPDATE securities_markets SET `+
+		`updated_at = CURRENT_TIMESTAMP, `+
+		`first_price_date = (SELECT MIN(date) FROM securities_markets_prices WHERE security_market_id = ?), `+
+		`last_price_date =  (SELECT MAX(date) FROM securities_markets_prices WHERE security_market_id = ?) `+
+		`WHERE id = ?`, securityMarketID, securityMarketID, securityMarketID).Error
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePricesRunning(t *testing.T) {
+	a := assert.New(t)
+
+	s := &priceService{}
+	s.updating.Lock()
+	a.ErrorIs(s.UpdatePrices(), ErrPriceUpdateRunning)
+	a.ErrorIs(s.StartPriceUpdate(), ErrPriceUpdateRunning)
+}
+
+func TestCheckSecurityPrice(t *testing.T) {
+	a := assert.New(t)
+
+	dec := func(s string) *decimal.Decimal {
+		d := decimal.RequireFromString(s)
+		return &d
+	}
+	volume := int64(-1)
+
+	a.Nil(checkSecurityPrice(&model.SecurityPrice{Close: *dec("999999.9999"), Open: dec("1.5000")}))
+	a.ErrorContains(checkSecurityPrice(&model.SecurityPrice{Close: *dec("1000000")}), "out of range")
+	a.ErrorContains(checkSecurityPrice(&model.SecurityPrice{Close: *dec("-1")}), "out of range")
+	a.ErrorContains(checkSecurityPrice(&model.SecurityPrice{Close: *dec("1"), High: dec("1.23456")}),
+		"high 1.23456 has more than 4 decimal places")
+	a.ErrorContains(checkSecurityPrice(&model.SecurityPrice{Close: *dec("1"), Volume: &volume}), "negative")
+	a.ErrorContains(checkSecurityPrice(&model.SecurityPrice{Close: *dec("1"), High: dec("1"), Low: dec("1.1")}),
+		"low 1.1 is greater than high 1")
+}
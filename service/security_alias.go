@@ -0,0 +1,84 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"gorm.io/gorm"
+)
+
+// normalizeSecurityAliases validates aliases and returns them with whitespace
+// collapsed, without duplicate names (case-insensitive) and sorted by kind and name.
+// Kind defaults to other.
+func normalizeSecurityAliases(aliases []*model.SecurityAlias) ([]*model.SecurityAlias, error) {
+	ret := []*model.SecurityAlias{}
+	seen := map[string]bool{}
+
+	for _, a := range aliases {
+		kind := model.SecurityAliasKind(strings.ToLower(strings.TrimSpace(a.Kind)))
+		if kind == "" {
+			kind = model.SecurityAliasKindOther
+		}
+		if !kind.IsValid() {
+			return nil, fmt.Errorf("unknown alias kind %q", a.Kind)
+		}
+
+		name := strings.Join(strings.Fields(a.Name), " ")
+		if name == "" {
+			return nil, fmt.Errorf("name of %s alias is empty", kind)
+		}
+		if len(name) > 200 {
+			return nil, fmt.Errorf("name of %s alias is too long", kind)
+		}
+
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		ret = append(ret, &model.SecurityAlias{Kind: string(kind), Name: name})
+	}
+
+	sort.Slice(ret, func(a, b int) bool {
+		if ret[a].Kind != ret[b].Kind {
+			return ret[a].Kind < ret[b].Kind
+		}
+		return ret[a].Name < ret[b].Name
+	})
+
+	return ret, nil
+}
+
+// replaceSecurityAliases sets aliases of security, aliases must be normalized
+func replaceSecurityAliases(tx *gorm.DB, securityUuid uuid.UUID, aliases []*model.SecurityAlias) {
+	if err := tx.Delete(&db.SecurityAlias{}, "security_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+	for _, a := range aliases {
+		alias := db.SecurityAlias{
+			SecurityUUID: securityUuid,
+			Kind:         a.Kind,
+			Name:         a.Name,
+		}
+		if err := tx.Create(&alias).Error; err != nil {
+			panic(err)
+		}
+	}
+}
+
+// securityAliasesModelFromDb converts aliases of security from database into model
+func securityAliasesModelFromDb(aliases []db.SecurityAlias) []*model.SecurityAlias {
+	ret := make([]*model.SecurityAlias, len(aliases))
+	for i := range aliases {
+		ret[i] = &model.SecurityAlias{
+			Kind: aliases[i].Kind,
+			Name: aliases[i].Name,
+		}
+	}
+	return ret
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSecurityAliases(t *testing.T) {
+	a := assert.New(t)
+
+	aliases, err := normalizeSecurityAliases([]*model.SecurityAlias{
+		{Kind: "Former", Name: " Facebook,   Inc. "},
+		{Kind: "abbreviation", Name: "FB"},
+		{Kind: "", Name: "Meta"},
+		{Kind: "local", Name: "facebook, inc."},
+	})
+	a.Nil(err)
+	a.Equal([]*model.SecurityAlias{
+		{Kind: "abbreviation", Name: "FB"},
+		{Kind: "former", Name: "Facebook, Inc."},
+		{Kind: "other", Name: "Meta"},
+	}, aliases)
+
+	for _, invalid := range [][]*model.SecurityAlias{
+		{{Kind: "nickname", Name: "Zuck"}},
+		{{Kind: "former", Name: " "}},
+	} {
+		_, err := normalizeSecurityAliases(invalid)
+		a.NotNil(err)
+	}
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// changeSecurities runs fn in transaction and records the previous state of each
+// security changed by fn in its history. If fn returns error, nothing is changed.
+// Existing securities are locked for the duration of the transaction.
+// Changed securities are marked as updated, their cached responses are
+// invalidated after commit.
+func (s *securityService) changeSecurities(
+	securityUuids []uuid.UUID,
+	user *model.User,
+	action model.SecurityChangeAction,
+	fn func(tx *gorm.DB) error,
+) error {
+	defer s.invalidateSecurities(securityUuids)
+
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		seen := map[uuid.UUID]bool{}
+		uuids := []uuid.UUID{}
+		for _, u := range securityUuids {
+			if !seen[u] {
+				seen[u] = true
+				uuids = append(uuids, u)
+			}
+		}
+		sort.Slice(uuids, func(i, j int) bool {
+			return bytes.Compare(uuids[i][:], uuids[j][:]) < 0
+		})
+
+		// Concurrent changes must not interleave between snapshot and history,
+		// rows are locked in order of UUIDs to avoid deadlocks (e.g. merges)
+		if len(uuids) > 0 {
+			if err := tx.Exec("SELECT 1 FROM securities WHERE uuid IN ? ORDER BY uuid FOR UPDATE", uuids).Error; err != nil {
+				panic(err)
+			}
+		}
+
+		previous := map[uuid.UUID]*model.SecuritySnapshot{}
+		for _, u := range uuids {
+			previous[u] = loadSecuritySnapshot(tx, u)
+		}
+
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		for _, u := range uuids {
+			before := marshalSecuritySnapshot(previous[u])
+			if bytes.Equal(before, marshalSecuritySnapshot(loadSecuritySnapshot(tx, u))) {
+				continue
+			}
+
+			var version int
+			if err := tx.Model(&db.SecurityHistory{}).
+				Select("COALESCE(MAX(version), 0)").
+				Where("security_uuid = ?", u).
+				Scan(&version).Error; err != nil {
+				panic(err)
+			}
+
+			entry := db.SecurityHistory{
+				SecurityUUID: u,
+				Version:      version + 1,
+				Action:       action,
+				Previous:     datatypes.JSON(before),
+			}
+			if user != nil {
+				userID := uint(user.ID)
+				entry.UserID = &userID
+			}
+			if err := tx.Create(&entry).Error; err != nil {
+				panic(err)
+			}
+
+			touchSecurity(tx, u)
+		}
+		return nil
+	})
+}
+
+// touchSecurity sets updated_at of security to current time, used for changes
+// of the security itself and of associated data, e.g. events
+func touchSecurity(tx *gorm.DB, securityUuid uuid.UUID) {
+	if err := tx.Exec("UPDATE securities SET updated_at = CURRENT_TIMESTAMP WHERE uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+}
+
+// invalidateSecurities invalidates cached responses of securities
+func (s *securityService) invalidateSecurities(securityUuids []uuid.UUID) {
+	if s.CacheService == nil {
+		return
+	}
+	tags := []string{}
+	for _, u := range securityUuids {
+		tags = append(tags, model.SecurityCacheTag(u))
+	}
+	s.CacheService.InvalidateTags(tags...)
+}
+
+// marshalSecuritySnapshot returns JSON of snapshot, nil if security does not exist
+func marshalSecuritySnapshot(snapshot *model.SecuritySnapshot) []byte {
+	if snapshot == nil {
+		return nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// loadSecuritySnapshot returns current master data of security, nil if it does not exist
+func loadSecuritySnapshot(tx *gorm.DB, securityUuid uuid.UUID) *model.SecuritySnapshot {
+	var security db.Security
+	result := tx.Scopes(db.PreloadIdentifiers, db.PreloadAliases).Limit(1).Find(&security, "uuid = ?", securityUuid)
+	if result.Error != nil {
+		panic(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	snapshot := &model.SecuritySnapshot{
+		Name:         security.Name,
+		Isin:         security.Isin,
+		Wkn:          security.Wkn,
+		SecurityType: security.SecurityType,
+		Identifiers:  securityIdentifiersModelFromDb(security.Identifiers),
+		Aliases:      securityAliasesModelFromDb(security.Aliases),
+		Extras:       json.RawMessage(security.Extras),
+		Markets:      []*model.SecuritySnapshotMarket{},
+		Taxonomies:   []*model.SecuritySnapshotTaxonomy{},
+		Tags:         []string{},
+	}
+
+	var markets []db.SecurityMarket
+	if err := tx.Order(`market_code COLLATE "C"`).Find(&markets, "security_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+	for _, m := range markets {
+		snapshot.Markets = append(snapshot.Markets, &model.SecuritySnapshotMarket{
+			MarketCode:   m.MarketCode,
+			CurrencyCode: m.CurrencyCode,
+			Symbol:       m.Symbol,
+			UpdatePrices: m.UpdatePrices,
+		})
+	}
+
+	var taxonomies []db.SecurityTaxonomy
+	if err := tx.Order("taxonomy_uuid").Find(&taxonomies, "security_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+	for _, t := range taxonomies {
+		snapshot.Taxonomies = append(snapshot.Taxonomies, &model.SecuritySnapshotTaxonomy{
+			TaxonomyUUID: t.TaxonomyUUID,
+			Weight:       t.Weight,
+		})
+	}
+
+	if err := tx.Table("tags t").
+		Joins("INNER JOIN securities_tags st ON st.tag_uuid = t.uuid").
+		Where("st.security_uuid = ?", securityUuid).
+		Order(`t.name COLLATE "C"`).
+		Pluck("t.name", &snapshot.Tags).Error; err != nil {
+		panic(err)
+	}
+
+	return snapshot
+}
+
+// GetSecurityHistory lists changes of security, latest first.
+// History of deleted securities is kept.
+func (s *securityService) GetSecurityHistory(securityUuid uuid.UUID) ([]*model.SecurityChange, error) {
+	var entries []struct {
+		db.SecurityHistory
+		Username *string
+	}
+	if err := s.DB.Table("securities_history h").
+		Select("h.*, u.username").
+		Joins("LEFT JOIN users u ON u.id = h.user_id").
+		Where("h.security_uuid = ?", securityUuid).
+		Order("h.version DESC").
+		Scan(&entries).Error; err != nil {
+		panic(err)
+	}
+
+	if len(entries) == 0 && loadSecuritySnapshot(s.DB, securityUuid) == nil {
+		return nil, model.ErrNotFound
+	}
+
+	changes := make([]*model.SecurityChange, len(entries))
+	for i, e := range entries {
+		previous, err := unmarshalSecuritySnapshot(e.Previous)
+		if err != nil {
+			panic(err)
+		}
+		changes[i] = &model.SecurityChange{
+			Version:   e.Version,
+			Action:    e.Action,
+			Previous:  previous,
+			ChangedBy: e.Username,
+			ChangedAt: e.CreatedAt,
+		}
+	}
+	return changes, nil
+}
+
+// unmarshalSecuritySnapshot parses snapshot stored in history, nil if security did not exist
+func unmarshalSecuritySnapshot(data datatypes.JSON) (*model.SecuritySnapshot, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var snapshot model.SecuritySnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// RevertSecurity restores master data of security stored with version, i.e. the
+// state before this change, and records this as new change. Prices of deleted
+// markets are not restored. Returns nil if security did not exist before version.
+func (s *securityService) RevertSecurity(securityUuid uuid.UUID, version int, user *model.User) (*model.Security, error) {
+	var entry db.SecurityHistory
+	err := s.DB.Take(&entry, "security_uuid = ? AND version = ?", securityUuid, version).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, model.ErrNotFound
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	snapshot, err := unmarshalSecuritySnapshot(entry.Previous)
+	if err != nil {
+		panic(err)
+	}
+
+	err = s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionRevert,
+		func(tx *gorm.DB) error {
+			return restoreSecuritySnapshot(tx, securityUuid, snapshot)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		return nil, nil
+	}
+	return s.GetSecurityByUUID(securityUuid)
+}
+
+// restoreSecuritySnapshot sets master data of security to snapshot,
+// security is deleted if snapshot is nil
+func restoreSecuritySnapshot(tx *gorm.DB, securityUuid uuid.UUID, snapshot *model.SecuritySnapshot) error {
+	if snapshot == nil {
+		if err := tx.Delete(&db.Security{}, "uuid = ?", securityUuid).Error; err != nil {
+			panic(err)
+		}
+		return nil
+	}
+
+	extras := datatypes.JSON(snapshot.Extras)
+	if len(extras) == 0 {
+		extras = datatypes.JSON("{}")
+	}
+	security := db.Security{
+		UUID:         securityUuid,
+		Name:         snapshot.Name,
+		Isin:         snapshot.Isin,
+		Wkn:          snapshot.Wkn,
+		SecurityType: snapshot.SecurityType,
+		Extras:       extras,
+	}
+	if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&security).Error; err != nil {
+		return constraintError(err)
+	}
+
+	// Identifiers and aliases of snapshot are normalized already
+	if err := replaceSecurityIdentifiers(tx, securityUuid, snapshot.Identifiers); err != nil {
+		return err
+	}
+
+	replaceSecurityAliases(tx, securityUuid, snapshot.Aliases)
+
+	// Security is not redirected anymore once restored
+	if err := tx.Delete(&db.SecurityRedirect{}, "old_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+
+	// Remove markets (incl. prices) not in snapshot, prices of other markets are kept
+	marketCodes := make([]string, len(snapshot.Markets))
+	for i, m := range snapshot.Markets {
+		marketCodes[i] = m.MarketCode
+	}
+	query := tx.Where("security_uuid = ?", securityUuid)
+	if len(marketCodes) > 0 {
+		query = query.Where("market_code NOT IN ?", marketCodes)
+	}
+	if err := query.Delete(&db.SecurityMarket{}).Error; err != nil {
+		panic(err)
+	}
+	for _, m := range snapshot.Markets {
+		market := db.SecurityMarket{
+			SecurityUUID: securityUuid.String(),
+			MarketCode:   m.MarketCode,
+			CurrencyCode: m.CurrencyCode,
+			Symbol:       m.Symbol,
+			UpdatePrices: m.UpdatePrices,
+		}
+		onConflict := clause.OnConflict{
+			Columns:   []clause.Column{{Name: "security_uuid"}, {Name: "market_code"}},
+			DoUpdates: clause.AssignmentColumns([]string{"currency_code", "symbol", "update_prices"}),
+		}
+		if err := tx.Clauses(onConflict).Create(&market).Error; err != nil {
+			return constraintError(err)
+		}
+	}
+
+	if err := tx.Delete(&db.SecurityTaxonomy{}, "security_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+	for _, t := range snapshot.Taxonomies {
+		taxonomy := db.SecurityTaxonomy{
+			SecurityUUID: securityUuid,
+			TaxonomyUUID: t.TaxonomyUUID,
+			Weight:       t.Weight,
+		}
+		if err := tx.Create(&taxonomy).Error; err != nil {
+			return constraintError(err)
+		}
+	}
+
+	if err := tx.Delete(&db.SecurityTag{}, "security_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+	for _, name := range snapshot.Tags {
+		// Tag may have been deleted in the meantime
+		var tag db.Tag
+		if err := tx.
+			Where("LOWER(name) = LOWER(?)", name).
+			Attrs(db.Tag{UUID: uuid.New()}).
+			FirstOrCreate(&tag, db.Tag{Name: name}).Error; err != nil {
+			panic(err)
+		}
+		if err := tx.Create(&db.SecurityTag{SecurityUUID: securityUuid, TagUUID: tag.UUID}).Error; err != nil {
+			panic(err)
+		}
+	}
+
+	return nil
+}
+
+// constraintError converts violation of constraint into error, panics on other errors
+func constraintError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && (pqErr.Code == "23503" || pqErr.Code == "23505") {
+		return fmt.Errorf("data violates constraint " + pqErr.Constraint)
+	}
+	panic(err)
+}
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// maxHoldingsWeight is upper limit of sum of weights of holdings in percent,
+// weights published by funds may exceed 100 due to rounding
+var maxHoldingsWeight = decimal.NewFromInt(101)
+
+// securityHoldingRow is one constituent of fund in upload
+type securityHoldingRow struct {
+	Line         int              `json:"-"`
+	SecurityUUID string           `json:"securityUuid"`
+	Isin         string           `json:"isin"`
+	Name         string           `json:"name"`
+	Weight       *decimal.Decimal `json:"weight"`
+}
+
+// GetSecurityHoldings returns constituents of fund sorted by weight,
+// empty if security has no holdings
+func (s *securityService) GetSecurityHoldings(securityUuid uuid.UUID) (*model.SecurityHoldings, error) {
+	var security db.Security
+	if err := s.DB.Take(&security, "uuid = ?", securityUuid).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.ErrNotFound
+		}
+		panic(err)
+	}
+
+	var rows []struct {
+		db.SecurityHolding
+		SecurityName *string
+		SecurityIsin *string
+	}
+	if err := s.DB.Table("securities_holdings h").
+		Select("h.*, s.name AS security_name, s.isin AS security_isin").
+		Joins("LEFT JOIN securities s ON s.uuid = h.constituent_uuid").
+		Where("h.security_uuid = ?", securityUuid).
+		Order("h.weight DESC, COALESCE(h.name, s.name), h.id").
+		Find(&rows).Error; err != nil {
+		panic(err)
+	}
+
+	holdings := &model.SecurityHoldings{Entries: []*model.SecurityHolding{}}
+	for i := range rows {
+		r := &rows[i]
+		asOf := r.AsOfDate
+		holdings.AsOf = &asOf
+
+		// Missing name and ISIN are taken from master data
+		holding := &model.SecurityHolding{
+			SecurityUUID: r.ConstituentUUID,
+			Name:         r.Name,
+			Isin:         r.Isin,
+			Weight:       r.Weight,
+		}
+		if holding.Name == nil {
+			holding.Name = r.SecurityName
+		}
+		if holding.Isin == nil {
+			holding.Isin = r.SecurityIsin
+		}
+		holdings.Entries = append(holdings.Entries, holding)
+	}
+
+	return holdings, nil
+}
+
+// UpdateSecurityHoldings replaces constituents of fund by holdings read from r.
+// Constituents are linked to master data by UUID (merged securities are resolved)
+// or unambiguous ISIN. Upload is rejected as a whole if any row is invalid.
+// asOf is required for CSV and takes precedence over asOf of JSON.
+func (s *securityService) UpdateSecurityHoldings(
+	securityUuid uuid.UUID, r io.Reader, format model.SecurityHoldingsFormat, asOf *model.Date,
+) (*model.SecurityHoldings, error) {
+	var rows []*securityHoldingRow
+	var err error
+	switch format {
+	case model.SecurityHoldingsFormatCSV:
+		rows, err = readSecurityHoldingsCSV(r)
+	case model.SecurityHoldingsFormatJSON:
+		var jsonAsOf *model.Date
+		rows, jsonAsOf, err = readSecurityHoldingsJSON(r)
+		if asOf == nil {
+			asOf = jsonAsOf
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if asOf == nil {
+		return nil, errors.New("asOf is required")
+	}
+
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&db.Security{}).Where("uuid = ?", securityUuid).Count(&count).Error; err != nil {
+			panic(err)
+		}
+		if count == 0 {
+			return model.ErrNotFound
+		}
+
+		holdings, err := s.holdingsFromRows(tx, securityUuid, *asOf, rows)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&db.SecurityHolding{}, "security_uuid = ?", securityUuid).Error; err != nil {
+			panic(err)
+		}
+		if len(holdings) > 0 {
+			if err := tx.Create(&holdings).Error; err != nil {
+				panic(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSecurityHoldings(securityUuid)
+}
+
+// holdingsFromRows validates rows and links constituents to master data
+func (s *securityService) holdingsFromRows(
+	tx *gorm.DB, securityUuid uuid.UUID, asOf model.Date, rows []*securityHoldingRow,
+) ([]db.SecurityHolding, error) {
+	holdings := make([]db.SecurityHolding, len(rows))
+	constituents := map[uuid.UUID]bool{}
+	total := decimal.Zero
+
+	for i, row := range rows {
+		lineError := func(format string, a ...any) error {
+			return fmt.Errorf("line %d: %s", row.Line, fmt.Sprintf(format, a...))
+		}
+
+		holding := db.SecurityHolding{SecurityUUID: securityUuid, AsOfDate: asOf}
+
+		if row.Weight == nil || !row.Weight.IsPositive() || row.Weight.GreaterThan(decimal.NewFromInt(100)) {
+			return nil, lineError("weight must be greater than 0 and at most 100")
+		}
+		holding.Weight = row.Weight.Round(4)
+		total = total.Add(holding.Weight)
+
+		if name := strings.TrimSpace(row.Name); name != "" {
+			holding.Name = &name
+		}
+		if isin := strings.ToUpper(strings.TrimSpace(row.Isin)); isin != "" {
+			if err := s.Validate.Var(isin, "Isin"); err != nil {
+				return nil, lineError("invalid ISIN %s", isin)
+			}
+			holding.Isin = &isin
+		}
+
+		if row.SecurityUUID != "" {
+			constituentUuid, err := uuid.Parse(row.SecurityUUID)
+			if err != nil {
+				return nil, lineError("invalid securityUuid %s", row.SecurityUUID)
+			}
+			constituentUuid = s.ResolveSecurityUUID(constituentUuid)
+			var count int64
+			if err := tx.Model(&db.Security{}).Where("uuid = ?", constituentUuid).Count(&count).Error; err != nil {
+				panic(err)
+			}
+			if count == 0 {
+				return nil, lineError("security %s does not exist", row.SecurityUUID)
+			}
+			holding.ConstituentUUID = &constituentUuid
+		} else if holding.Isin != nil {
+			// Only primary ISIN of securities is matched
+			var uuids []uuid.UUID
+			if err := tx.Model(&db.Security{}).
+				Where("isin = ?", *holding.Isin).
+				Limit(2).
+				Pluck("uuid", &uuids).Error; err != nil {
+				panic(err)
+			}
+			if len(uuids) == 1 {
+				holding.ConstituentUUID = &uuids[0]
+			}
+		}
+
+		if holding.ConstituentUUID == nil && holding.Name == nil && holding.Isin == nil {
+			return nil, lineError("securityUuid, isin or name is required")
+		}
+		if holding.ConstituentUUID != nil {
+			if *holding.ConstituentUUID == securityUuid {
+				return nil, lineError("fund cannot hold itself")
+			}
+			if constituents[*holding.ConstituentUUID] {
+				return nil, lineError("security %s is listed twice", *holding.ConstituentUUID)
+			}
+			constituents[*holding.ConstituentUUID] = true
+		}
+
+		holdings[i] = holding
+	}
+
+	if total.GreaterThan(maxHoldingsWeight) {
+		return nil, fmt.Errorf("sum of weights %s exceeds %s", total, maxHoldingsWeight)
+	}
+
+	return holdings, nil
+}
+
+// readSecurityHoldingsCSV reads CSV with header, columns may be in any order
+func readSecurityHoldingsCSV(r io.Reader) ([]*securityHoldingRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []*securityHoldingRow{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["weight"]; !ok {
+		return nil, errors.New("column weight is missing")
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	rows := []*securityHoldingRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &securityHoldingRow{
+			Line:         line,
+			SecurityUUID: field(record, "securityUuid"),
+			Isin:         field(record, "isin"),
+			Name:         field(record, "name"),
+		}
+		if weight := field(record, "weight"); weight != "" {
+			d, err := decimal.NewFromString(weight)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid weight %q", line, weight)
+			}
+			row.Weight = &d
+		}
+		rows = append(rows, row)
+	}
+}
+
+// readSecurityHoldingsJSON reads object with asOf and holdings, lines
+// of rows are numbered by position in holdings starting at 1
+func readSecurityHoldingsJSON(r io.Reader) ([]*securityHoldingRow, *model.Date, error) {
+	var body struct {
+		AsOf     *model.Date           `json:"asOf"`
+		Holdings []*securityHoldingRow `json:"holdings"`
+	}
+	if err := json.NewDecoder(r).Decode(&body); err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	rows := []*securityHoldingRow{}
+	for i, row := range body.Holdings {
+		if row == nil {
+			return nil, nil, fmt.Errorf("line %d: holding is null", i+1)
+		}
+		row.Line = i + 1
+		rows = append(rows, row)
+	}
+	return rows, body.AsOf, nil
+}
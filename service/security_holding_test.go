@@ -0,0 +1,98 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSecurityHoldingsCSV(t *testing.T) {
+	a := assert.New(t)
+
+	rows, err := readSecurityHoldingsCSV(strings.NewReader(
+		"name, weight,isin\n" +
+			"SAP SE,12.5,DE0007164600\n" +
+			"Cash,,\n"))
+	a.Nil(err)
+	weight := decimal.RequireFromString("12.5")
+	a.Equal([]*securityHoldingRow{
+		{Line: 2, Name: "SAP SE", Isin: "DE0007164600", Weight: &weight},
+		{Line: 3, Name: "Cash"},
+	}, rows)
+
+	rows, err = readSecurityHoldingsCSV(strings.NewReader(""))
+	a.Nil(err)
+	a.Empty(rows)
+
+	_, err = readSecurityHoldingsCSV(strings.NewReader("name\nCash\n"))
+	a.NotNil(err)
+	_, err = readSecurityHoldingsCSV(strings.NewReader("name,weight\nCash,ten\n"))
+	a.EqualError(err, `line 2: invalid weight "ten"`)
+}
+
+func TestReadSecurityHoldingsJSON(t *testing.T) {
+	a := assert.New(t)
+
+	rows, asOf, err := readSecurityHoldingsJSON(strings.NewReader(
+		`{"asOf":"2022-03-31","holdings":[{"name":"Cash","weight":"4.5"},{"isin":"DE0007164600","weight":95.5}]}`))
+	a.Nil(err)
+	a.Equal("2022-03-31", asOf.String())
+	a.Len(rows, 2)
+	a.Equal(2, rows[1].Line)
+	a.Equal("95.5", rows[1].Weight.String())
+
+	_, _, err = readSecurityHoldingsJSON(strings.NewReader(`{"holdings":[null]}`))
+	a.EqualError(err, "line 1: holding is null")
+	_, _, err = readSecurityHoldingsJSON(strings.NewReader(`[]`))
+	a.NotNil(err)
+}
+
+func TestAllocationLookThrough(t *testing.T) {
+	a := assert.New(t)
+
+	fund, fundOfFunds, stock := uuid.New(), uuid.New(), uuid.New()
+	tech, funds := uuid.New(), uuid.New()
+	alloc := &allocation{
+		taxonomies: map[uuid.UUID][]weightedUUID{
+			stock: {{UUID: tech, Weight: hundred}},
+			fund:  {{UUID: funds, Weight: decimal.NewFromInt(50)}},
+		},
+		holdings: map[uuid.UUID][]weightedUUID{
+			fundOfFunds: {{UUID: fund, Weight: hundred}},
+			fund:        {{UUID: stock, Weight: decimal.NewFromInt(60)}},
+		},
+		values: map[uuid.UUID]decimal.Decimal{},
+	}
+	alloc.add(fundOfFunds, decimal.NewFromInt(1000), 0)
+
+	a.Equal("600", alloc.values[tech].String())
+	a.Equal("200", alloc.values[funds].String())
+	a.Equal("200", alloc.values[uuid.Nil].String())
+}
+
+func TestSortAllocationEntries(t *testing.T) {
+	a := assert.New(t)
+
+	name := func(s string) *string { return &s }
+	entries := []*model.PortfolioAllocationEntry{
+		{Value: decimal.NewFromInt(10)},
+		{Name: name("B"), Value: decimal.NewFromInt(10)},
+		{Name: name("C"), Value: decimal.NewFromInt(20)},
+		{Name: name("A"), Value: decimal.NewFromInt(10)},
+	}
+	sortAllocationEntries(entries)
+
+	names := []string{}
+	for _, e := range entries {
+		if e.Name == nil {
+			names = append(names, "")
+		} else {
+			names = append(names, *e.Name)
+		}
+	}
+	a.Equal([]string{"C", "A", "B", ""}, names)
+}
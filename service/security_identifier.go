@@ -0,0 +1,253 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+	"gorm.io/gorm"
+)
+
+// micRegexp matches market identifier code (ISO 10383)
+var micRegexp = regexp.MustCompile(`^[A-Z0-9]{4}$`)
+
+// legacySymbolMics are markets of tickers exposed as symbolXfra, symbolXnas and symbolXnys
+var legacySymbolMics = []string{"XFRA", "XNAS", "XNYS"}
+
+// identifierValidations are validations of values of identifier schemes
+var identifierValidations = map[model.SecurityIdentifierScheme]string{
+	model.SecurityIdentifierSchemeIsin:  "Isin",
+	model.SecurityIdentifierSchemeWkn:   "Wkn",
+	model.SecurityIdentifierSchemeCusip: "Cusip",
+}
+
+// normalizeSecurityIdentifiers validates identifiers and returns them upper-cased,
+// without duplicates and sorted by scheme, MIC and value. Values of previous
+// identifiers are not validated again, so that legacy values can be kept.
+func normalizeSecurityIdentifiers(
+	validate *validator.Validate, identifiers []*model.SecurityIdentifier, previous []*model.SecurityIdentifier,
+) ([]*model.SecurityIdentifier, error) {
+	ret := []*model.SecurityIdentifier{}
+	seen := map[string]bool{}
+	tickers := map[string]bool{}
+
+	existing := map[string]bool{}
+	for _, i := range previous {
+		existing[i.Scheme+"@"+micOf(i)+":"+i.Value] = true
+	}
+
+	for _, i := range identifiers {
+		scheme := model.SecurityIdentifierScheme(strings.ToLower(strings.TrimSpace(i.Scheme)))
+		if !scheme.IsValid() {
+			return nil, fmt.Errorf("unknown identifier scheme %q", i.Scheme)
+		}
+
+		value := strings.ToUpper(strings.TrimSpace(i.Value))
+		if value == "" {
+			return nil, fmt.Errorf("value of %s identifier is empty", scheme)
+		}
+		if len(value) > 50 {
+			return nil, fmt.Errorf("value of %s identifier is too long", scheme)
+		}
+		mic := ""
+		if i.Mic != nil {
+			mic = strings.ToUpper(strings.TrimSpace(*i.Mic))
+		}
+		key := string(scheme) + "@" + mic + ":" + value
+
+		if tag, ok := identifierValidations[scheme]; ok && !existing[key] {
+			if err := validate.Var(value, tag); err != nil {
+				return nil, fmt.Errorf("invalid %s %s", scheme, value)
+			}
+		}
+		if scheme == model.SecurityIdentifierSchemeTicker {
+			if !micRegexp.MatchString(mic) {
+				return nil, fmt.Errorf("ticker %s requires MIC of market", value)
+			}
+		} else if mic != "" {
+			return nil, fmt.Errorf("%s identifier must not have MIC", scheme)
+		}
+
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if scheme == model.SecurityIdentifierSchemeTicker {
+			if tickers[mic] {
+				return nil, fmt.Errorf("multiple tickers for market %s", mic)
+			}
+			tickers[mic] = true
+		}
+
+		identifier := &model.SecurityIdentifier{Scheme: string(scheme), Value: value}
+		if mic != "" {
+			identifier.Mic = &mic
+		}
+		ret = append(ret, identifier)
+	}
+
+	sort.Slice(ret, func(a, b int) bool {
+		if ret[a].Scheme != ret[b].Scheme {
+			return ret[a].Scheme < ret[b].Scheme
+		}
+		if micOf(ret[a]) != micOf(ret[b]) {
+			return micOf(ret[a]) < micOf(ret[b])
+		}
+		return ret[a].Value < ret[b].Value
+	})
+
+	return ret, nil
+}
+
+// micOf returns MIC of identifier, empty if not set
+func micOf(i *model.SecurityIdentifier) string {
+	if i.Mic == nil {
+		return ""
+	}
+	return *i.Mic
+}
+
+// normalizeSecurityCodes validates ISIN and WKN of input, both are upper-cased
+// and empty values are removed. WKN is derived from German ISIN if not given.
+// Values of previous security (nil for new security) are not validated again,
+// so that securities with legacy values can still be updated.
+func normalizeSecurityCodes(validate *validator.Validate, input *model.SecurityInput, previous *model.Security) error {
+	var previousIsin, previousWkn *string
+	if previous != nil {
+		previousIsin, previousWkn = previous.Isin, previous.Wkn
+	}
+
+	for _, code := range []struct {
+		value    **string
+		previous *string
+		tag      string
+	}{{&input.Isin, previousIsin, "Isin"}, {&input.Wkn, previousWkn, "Wkn"}} {
+		if *code.value == nil {
+			continue
+		}
+		value := strings.ToUpper(strings.TrimSpace(**code.value))
+		if value == "" {
+			*code.value = nil
+			continue
+		}
+		if code.previous != nil && strings.EqualFold(value, *code.previous) {
+			*code.value = &value
+			continue
+		}
+		if err := validate.Var(value, code.tag); err != nil {
+			return fmt.Errorf("invalid %s %s", strings.ToUpper(code.tag), value)
+		}
+		*code.value = &value
+	}
+
+	if input.Isin != nil && input.Wkn == nil {
+		if wkn, ok := libs.WknFromIsin(*input.Isin); ok {
+			input.Wkn = &wkn
+		}
+	}
+	return nil
+}
+
+// securityIdentifiersFromInput returns identifiers of input, legacy symbol attributes
+// which are set replace ticker of their market (empty symbol removes ticker).
+// ISIN and WKN identifiers equal to attributes of input are left out, codes of input
+// must be normalized. CUSIP is derived from ISIN of US and Canada if not given.
+// Identifiers of previous security (nil for new security) are not validated again.
+func securityIdentifiersFromInput(
+	validate *validator.Validate, input *model.SecurityInput, previous *model.Security,
+) ([]*model.SecurityIdentifier, error) {
+	symbols := map[string]*string{
+		"XFRA": input.SymbolXfra,
+		"XNAS": input.SymbolXnas,
+		"XNYS": input.SymbolXnys,
+	}
+	codes := map[model.SecurityIdentifierScheme]*string{
+		model.SecurityIdentifierSchemeIsin: input.Isin,
+		model.SecurityIdentifierSchemeWkn:  input.Wkn,
+	}
+
+	identifiers := []*model.SecurityIdentifier{}
+	hasCusip := false
+	for _, i := range input.Identifiers {
+		if i.Scheme == string(model.SecurityIdentifierSchemeTicker) && i.Mic != nil &&
+			symbols[strings.ToUpper(strings.TrimSpace(*i.Mic))] != nil {
+			continue
+		}
+		scheme := model.SecurityIdentifierScheme(strings.ToLower(strings.TrimSpace(i.Scheme)))
+		if code := codes[scheme]; code != nil && strings.EqualFold(strings.TrimSpace(i.Value), *code) {
+			continue
+		}
+		if scheme == model.SecurityIdentifierSchemeCusip {
+			hasCusip = true
+		}
+		identifiers = append(identifiers, i)
+	}
+
+	for _, mic := range legacySymbolMics {
+		if symbol := symbols[mic]; symbol != nil && strings.TrimSpace(*symbol) != "" {
+			mic := mic
+			identifiers = append(identifiers, &model.SecurityIdentifier{
+				Scheme: string(model.SecurityIdentifierSchemeTicker),
+				Mic:    &mic,
+				Value:  *symbol,
+			})
+		}
+	}
+
+	if input.Isin != nil && !hasCusip {
+		if cusip, ok := libs.CusipFromIsin(strings.ToUpper(strings.TrimSpace(*input.Isin))); ok {
+			identifiers = append(identifiers, &model.SecurityIdentifier{
+				Scheme: string(model.SecurityIdentifierSchemeCusip),
+				Value:  cusip,
+			})
+		}
+	}
+
+	var previousIdentifiers []*model.SecurityIdentifier
+	if previous != nil {
+		previousIdentifiers = previous.Identifiers
+	}
+	return normalizeSecurityIdentifiers(validate, identifiers, previousIdentifiers)
+}
+
+// replaceSecurityIdentifiers sets identifiers of security, identifiers must be normalized
+func replaceSecurityIdentifiers(tx *gorm.DB, securityUuid uuid.UUID, identifiers []*model.SecurityIdentifier) error {
+	if err := tx.Delete(&db.SecurityIdentifier{}, "security_uuid = ?", securityUuid).Error; err != nil {
+		panic(err)
+	}
+	for _, i := range identifiers {
+		identifier := db.SecurityIdentifier{
+			SecurityUUID: securityUuid,
+			Scheme:       i.Scheme,
+			Mic:          micOf(i),
+			Value:        i.Value,
+		}
+		if err := tx.Create(&identifier).Error; err != nil {
+			return constraintError(err)
+		}
+	}
+	return nil
+}
+
+// securityIdentifiersModelFromDb converts identifiers of security from database into model
+func securityIdentifiersModelFromDb(identifiers []db.SecurityIdentifier) []*model.SecurityIdentifier {
+	ret := make([]*model.SecurityIdentifier, len(identifiers))
+	for i := range identifiers {
+		ret[i] = &model.SecurityIdentifier{
+			Scheme: identifiers[i].Scheme,
+			Value:  identifiers[i].Value,
+		}
+		if identifiers[i].Mic != "" {
+			mic := identifiers[i].Mic
+			ret[i].Mic = &mic
+		}
+	}
+	return ret
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSecurityIdentifiers(t *testing.T) {
+	a := assert.New(t)
+	str := func(s string) *string { return &s }
+
+	identifiers, err := normalizeSecurityIdentifiers(libs.GetValidator(), []*model.SecurityIdentifier{
+		{Scheme: "ticker", Mic: str("xlon"), Value: " vod "},
+		{Scheme: "FIGI", Value: "bbg000c6k6g9"},
+		{Scheme: "ticker", Mic: str("XETR"), Value: "VODI"},
+		{Scheme: "figi", Value: "BBG000C6K6G9"},
+		{Scheme: "sedol", Mic: str(""), Value: "BH4HKS3"},
+		{Scheme: "isin", Value: "gb00bh4hks39"},
+	}, nil)
+	a.Nil(err)
+	a.Equal([]*model.SecurityIdentifier{
+		{Scheme: "figi", Value: "BBG000C6K6G9"},
+		{Scheme: "isin", Value: "GB00BH4HKS39"},
+		{Scheme: "sedol", Value: "BH4HKS3"},
+		{Scheme: "ticker", Mic: str("XETR"), Value: "VODI"},
+		{Scheme: "ticker", Mic: str("XLON"), Value: "VOD"},
+	}, identifiers)
+
+	for _, invalid := range [][]*model.SecurityIdentifier{
+		{{Scheme: "reuters", Value: "VOD.L"}},
+		{{Scheme: "isin", Value: "DE0007164601"}},
+		{{Scheme: "WKN", Value: "71646O"}},
+		{{Scheme: "ticker", Value: "VOD"}},
+		{{Scheme: "ticker", Mic: str("LSE"), Value: "VOD"}},
+		{{Scheme: "figi", Value: " "}},
+		{{Scheme: "valor", Mic: str("XSWX"), Value: "22826893"}},
+		{{Scheme: "cusip", Value: "037833101"}},
+		{{Scheme: "ticker", Mic: str("XLON"), Value: "VOD"}, {Scheme: "ticker", Mic: str("XLON"), Value: "VODL"}},
+	} {
+		_, err := normalizeSecurityIdentifiers(libs.GetValidator(), invalid, nil)
+		a.NotNil(err)
+	}
+
+	// Unchanged legacy values are not validated again
+	legacy := []*model.SecurityIdentifier{{Scheme: "isin", Value: "DE0007164601"}}
+	_, err = normalizeSecurityIdentifiers(libs.GetValidator(),
+		[]*model.SecurityIdentifier{{Scheme: "isin", Value: "de0007164601"}}, legacy)
+	a.Nil(err)
+}
+
+func TestSecurityIdentifiersFromInput(t *testing.T) {
+	a := assert.New(t)
+	str := func(s string) *string { return &s }
+
+	// Legacy symbols replace tickers of their market, empty symbols remove them,
+	// tickers without symbol are kept
+	identifiers, err := securityIdentifiersFromInput(libs.GetValidator(), &model.SecurityInput{
+		SymbolXfra: str("vodi"),
+		SymbolXnas: str(""),
+		Identifiers: []*model.SecurityIdentifier{
+			{Scheme: "ticker", Mic: str("XFRA"), Value: "VOD"},
+			{Scheme: "ticker", Mic: str("XNAS"), Value: "VOD"},
+			{Scheme: "ticker", Mic: str("XNYS"), Value: "VOD"},
+			{Scheme: "valor", Value: "22826893"},
+		},
+	}, nil)
+	a.Nil(err)
+	a.Equal([]*model.SecurityIdentifier{
+		{Scheme: "ticker", Mic: str("XFRA"), Value: "VODI"},
+		{Scheme: "ticker", Mic: str("XNYS"), Value: "VOD"},
+		{Scheme: "valor", Value: "22826893"},
+	}, identifiers)
+}
+
+func TestNormalizeSecurityCodes(t *testing.T) {
+	a := assert.New(t)
+	str := func(s string) *string { return &s }
+
+	// WKN is derived from German ISIN
+	input := &model.SecurityInput{Isin: str(" de0007164600")}
+	a.Nil(normalizeSecurityCodes(libs.GetValidator(), input, nil))
+	a.Equal("DE0007164600", *input.Isin)
+	a.Equal("716460", *input.Wkn)
+
+	// Given WKN is kept, empty values are removed
+	input = &model.SecurityInput{Isin: str("DE0007164600"), Wkn: str("a0f5uf")}
+	a.Nil(normalizeSecurityCodes(libs.GetValidator(), input, nil))
+	a.Equal("A0F5UF", *input.Wkn)
+	input = &model.SecurityInput{Isin: str(""), Wkn: str(" ")}
+	a.Nil(normalizeSecurityCodes(libs.GetValidator(), input, nil))
+	a.Nil(input.Isin)
+	a.Nil(input.Wkn)
+
+	a.NotNil(normalizeSecurityCodes(libs.GetValidator(), &model.SecurityInput{Isin: str("DE0007164601")}, nil))
+	a.NotNil(normalizeSecurityCodes(libs.GetValidator(), &model.SecurityInput{Wkn: str("71646O")}, nil))
+
+	// Unchanged legacy values are not validated again
+	previous := &model.Security{Isin: str("DE0007164601"), Wkn: str("71646O")}
+	input = &model.SecurityInput{Isin: str("de0007164601"), Wkn: str("71646O")}
+	a.Nil(normalizeSecurityCodes(libs.GetValidator(), input, previous))
+	a.Equal("DE0007164601", *input.Isin)
+	a.NotNil(normalizeSecurityCodes(libs.GetValidator(), &model.SecurityInput{Isin: str("DE0007164602")}, previous))
+
+	// CUSIP is derived from US ISIN unless given
+	identifiers, err := securityIdentifiersFromInput(libs.GetValidator(), &model.SecurityInput{Isin: str("US0378331005")}, nil)
+	a.Nil(err)
+	a.Equal([]*model.SecurityIdentifier{{Scheme: "cusip", Value: "037833100"}}, identifiers)
+
+	identifiers, err = securityIdentifiersFromInput(libs.GetValidator(), &model.SecurityInput{
+		Isin:        str("US0378331005"),
+		Identifiers: []*model.SecurityIdentifier{{Scheme: "cusip", Value: "38259P508"}},
+	}, nil)
+	a.Nil(err)
+	a.Equal([]*model.SecurityIdentifier{{Scheme: "cusip", Value: "38259P508"}}, identifiers)
+
+	// ISIN and WKN identifiers equal to attributes are left out
+	identifiers, err = securityIdentifiersFromInput(libs.GetValidator(), &model.SecurityInput{
+		Isin: str("DE0007164600"),
+		Wkn:  str("716460"),
+		Identifiers: []*model.SecurityIdentifier{
+			{Scheme: "isin", Value: "de0007164600"},
+			{Scheme: "WKN", Value: "716460"},
+			{Scheme: "isin", Value: "DE000A0F5UF5"},
+		},
+	}, nil)
+	a.Nil(err)
+	a.Equal([]*model.SecurityIdentifier{{Scheme: "isin", Value: "DE000A0F5UF5"}}, identifiers)
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// maxLogoBytes limits size of uploaded logos
+	maxLogoBytes = 5 << 20
+	// maxLogoPixels limits number of pixels of uploaded raster logos before decoding
+	maxLogoPixels = 5000 * 5000
+	// maxLogoSize is maximum width and height of stored raster logos
+	maxLogoSize = 512
+	// logoThumbSize is maximum width and height of thumbnails of raster logos
+	logoThumbSize = 64
+	// logoCacheControl is used for logos, which are never changed since file names are random
+	logoCacheControl = "public, max-age=31536000, immutable"
+)
+
+// logoImage is validated and normalized logo with thumbnail
+type logoImage struct {
+	Extension   string
+	ContentType string
+	Data        []byte
+	Thumb       []byte
+}
+
+// unsafeSvgElements are removed including their content
+var unsafeSvgElements = map[string]bool{
+	"script":           true,
+	"foreignobject":    true,
+	"iframe":           true,
+	"embed":            true,
+	"object":           true,
+	"handler":          true,
+	"listener":         true,
+	"set":              true,
+	"animate":          true,
+	"animatemotion":    true,
+	"animatetransform": true,
+}
+
+// processLogo validates logo by its content. SVGs are sanitized, PNG, JPEG and WebP
+// are re-encoded (WebP as PNG) to fit maxLogoSize and a thumbnail is created.
+func processLogo(r io.Reader) (*logoImage, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxLogoBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxLogoBytes {
+		return nil, fmt.Errorf("logo exceeds %d bytes", maxLogoBytes)
+	}
+
+	switch http.DetectContentType(data) {
+	case "image/png", "image/jpeg", "image/webp":
+		return processRasterLogo(data)
+	case "text/xml; charset=utf-8", "text/plain; charset=utf-8":
+		svg, err := sanitizeSvg(data)
+		if err != nil {
+			return nil, err
+		}
+		return &logoImage{Extension: ".svg", ContentType: "image/svg+xml", Data: svg, Thumb: svg}, nil
+	default:
+		return nil, errors.New("logo must be PNG, JPEG, WebP or SVG")
+	}
+}
+
+// processRasterLogo decodes image and encodes it and its thumbnail,
+// JPEG is kept as JPEG, other formats are encoded as PNG to keep transparency
+func processRasterLogo(data []byte) (*logoImage, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxLogoPixels {
+		return nil, fmt.Errorf("image dimensions %dx%d not supported", config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+
+	encode := func(img image.Image) ([]byte, error) {
+		var buf bytes.Buffer
+		var err error
+		if format == "jpeg" {
+			err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+		} else {
+			err = png.Encode(&buf, img)
+		}
+		return buf.Bytes(), err
+	}
+
+	logo := &logoImage{Extension: ".png", ContentType: "image/png"}
+	if format == "jpeg" {
+		logo.Extension, logo.ContentType = ".jpg", "image/jpeg"
+	}
+	if logo.Data, err = encode(scaleImage(img, maxLogoSize)); err != nil {
+		return nil, err
+	}
+	if logo.Thumb, err = encode(scaleImage(img, logoThumbSize)); err != nil {
+		return nil, err
+	}
+	return logo, nil
+}
+
+// scaleImage scales image down to fit into size x size, keeping aspect ratio
+func scaleImage(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, h*size/w
+		} else {
+			w, h = w*size/h, size
+		}
+		if w < 1 {
+			w = 1
+		}
+		if h < 1 {
+			h = 1
+		}
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst
+}
+
+// sanitizeSvg parses SVG and writes it again without scripts, event handlers,
+// animations, external references, comments and DTDs. Content of style elements
+// is kept only if it is safe as a whole.
+func sanitizeSvg(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	var out bytes.Buffer
+	out.WriteString(xml.Header)
+
+	stack := []string{}
+	skip := 0
+	root := false
+	var style *bytes.Buffer // content of current style element, written at its end
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid SVG: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skip > 0 || style != nil {
+				skip++
+				continue
+			}
+			if len(stack) == 0 {
+				if root || t.Name.Local != "svg" {
+					return nil, errors.New("invalid SVG: root element must be svg")
+				}
+				root = true
+			}
+			if unsafeSvgElements[strings.ToLower(t.Name.Local)] {
+				skip = 1
+				continue
+			}
+
+			name := qualifiedXmlName(t.Name)
+			stack = append(stack, name)
+			out.WriteString("<" + name)
+			for _, attr := range t.Attr {
+				if !isSafeSvgAttr(attr) {
+					continue
+				}
+				out.WriteString(" " + qualifiedXmlName(attr.Name) + `="`)
+				xml.EscapeText(&out, []byte(attr.Value))
+				out.WriteString(`"`)
+			}
+			out.WriteString(">")
+			if strings.ToLower(t.Name.Local) == "style" {
+				style = &bytes.Buffer{}
+			}
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			name := qualifiedXmlName(t.Name)
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return nil, fmt.Errorf("invalid SVG: unexpected end element %s", name)
+			}
+			stack = stack[:len(stack)-1]
+			if style != nil {
+				if isSafeCss(style.String()) {
+					xml.EscapeText(&out, style.Bytes())
+				}
+				style = nil
+			}
+			out.WriteString("</" + name + ">")
+		case xml.CharData:
+			if skip == 0 && style != nil {
+				style.Write(t)
+			} else if skip == 0 && len(stack) > 0 {
+				xml.EscapeText(&out, t)
+			}
+		}
+	}
+
+	if !root || len(stack) > 0 {
+		return nil, errors.New("invalid SVG: incomplete document")
+	}
+	return out.Bytes(), nil
+}
+
+// isSafeSvgAttr reports whether attribute can neither execute scripts nor load external resources
+func isSafeSvgAttr(attr xml.Attr) bool {
+	name := strings.ToLower(attr.Name.Local)
+	value := strings.ToLower(strings.Join(strings.Fields(attr.Value), ""))
+
+	if strings.HasPrefix(name, "on") {
+		return false
+	}
+	if strings.Contains(value, "javascript:") {
+		return false
+	}
+	if name == "href" {
+		return isSafeSvgReference(value)
+	}
+	// Presentation attributes like fill or filter are parsed as CSS
+	return isSafeCss(attr.Value)
+}
+
+// isSafeCss reports whether CSS of style element or attribute refers to
+// nothing but fragments and embedded images. Escapes are rejected, since
+// they could hide functions and at-rules.
+func isSafeCss(css string) bool {
+	value := strings.ToLower(strings.Join(strings.Fields(css), ""))
+
+	if strings.Contains(value, `\`) ||
+		strings.Contains(value, "@import") ||
+		strings.Contains(value, "javascript:") ||
+		strings.Contains(value, "expression(") ||
+		strings.Contains(value, "image-set(") ||
+		strings.Contains(value, "src(") {
+		return false
+	}
+	for rest := value; ; {
+		i := strings.Index(rest, "url(")
+		if i < 0 {
+			return true
+		}
+		rest = rest[i+len("url("):]
+		if !isSafeSvgReference(strings.TrimLeft(rest, `"'`)) {
+			return false
+		}
+	}
+}
+
+// isSafeSvgReference reports whether normalized reference is a fragment or an embedded raster image
+func isSafeSvgReference(value string) bool {
+	return strings.HasPrefix(value, "#") ||
+		strings.HasPrefix(value, "data:image/png") ||
+		strings.HasPrefix(value, "data:image/jpeg") ||
+		strings.HasPrefix(value, "data:image/gif") ||
+		strings.HasPrefix(value, "data:image/webp")
+}
+
+// qualifiedXmlName returns name with prefix as in document
+func qualifiedXmlName(name xml.Name) string {
+	if name.Space != "" {
+		return name.Space + ":" + name.Local
+	}
+	return name.Local
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessLogoRaster(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1024, 256))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 128})
+
+	var pngData bytes.Buffer
+	require.Nil(t, png.Encode(&pngData, img))
+	logo, err := processLogo(bytes.NewReader(pngData.Bytes()))
+	require.Nil(t, err)
+	assert.Equal(t, ".png", logo.Extension)
+	assert.Equal(t, "image/png", logo.ContentType)
+
+	config, err := png.DecodeConfig(bytes.NewReader(logo.Data))
+	require.Nil(t, err)
+	assert.Equal(t, []int{512, 128}, []int{config.Width, config.Height})
+	config, err = png.DecodeConfig(bytes.NewReader(logo.Thumb))
+	require.Nil(t, err)
+	assert.Equal(t, []int{64, 16}, []int{config.Width, config.Height})
+
+	// Small JPEG is re-encoded without scaling
+	var jpegData bytes.Buffer
+	require.Nil(t, jpeg.Encode(&jpegData, image.NewRGBA(image.Rect(0, 0, 32, 48)), nil))
+	logo, err = processLogo(bytes.NewReader(jpegData.Bytes()))
+	require.Nil(t, err)
+	assert.Equal(t, ".jpg", logo.Extension)
+	assert.Equal(t, "image/jpeg", logo.ContentType)
+	config, err = jpeg.DecodeConfig(bytes.NewReader(logo.Data))
+	require.Nil(t, err)
+	assert.Equal(t, []int{32, 48}, []int{config.Width, config.Height})
+
+	for _, invalid := range [][]byte{
+		[]byte("GIF89a"),
+		pngData.Bytes()[:100],
+		{0xff, 0xd8, 0xff, 0xe0, 0x00},
+		[]byte("<html><script>alert(1)</script></html>"),
+	} {
+		_, err := processLogo(bytes.NewReader(invalid))
+		assert.NotNil(t, err)
+	}
+}
+
+func TestSanitizeSvg(t *testing.T) {
+	logo, err := processLogo(strings.NewReader(`<?xml version="1.0"?>
+<!DOCTYPE svg [<!ENTITY x "y">]>
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" onload="alert(1)">
+<!-- comment -->
+<script>alert(2)</script>
+<style>.a { fill: red; }</style>
+<a xlink:href="javascript:alert(3)"><rect class="a" width="10" height="10" OnClick="alert(4)"/></a>
+<use href="#r"/><image href="https://example.com/track.png"/>
+<foreignObject><div xmlns="http://www.w3.org/1999/xhtml"><script>alert(5)</script></div></foreignObject>
+<set attributeName="href" to="javascript:alert(6)"/>
+<path d="M0 0 L1 1" style="fill: url(j a v a s c r i p t:alert(7))" fill="#000"/>
+<style>@import url(https://example.com/a.css);</style>
+<style>.b { fill: url( 'https://example.com/b.png' ); }</style>
+<style>.c { fill: ur<![CDATA[l(https://example.com/c.png)]]>; }</style>
+<style>.d { fill: \75rl(https://example.com/d.png); }</style>
+<style>.e { fill: url(#g); }</style>
+<circle fill="url(https://example.com/e.png)" filter="url(#f)" style="background: url('data:image/png;base64,AA')"/>
+<rect mask="URL(//example.com/m.svg#m)" style="fill: image-set('https://example.com/i.png' 1x)"/>
+</svg>`))
+	require.Nil(t, err)
+	assert.Equal(t, ".svg", logo.Extension)
+	assert.Equal(t, "image/svg+xml", logo.ContentType)
+	assert.Equal(t, xml.Header+
+		`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink">`+"\n\n\n"+
+		`<style>.a { fill: red; }</style>`+"\n"+
+		`<a><rect class="a" width="10" height="10"></rect></a>`+"\n"+
+		`<use href="#r"></use><image></image>`+"\n\n\n"+
+		`<path d="M0 0 L1 1" fill="#000"></path>`+"\n"+
+		`<style></style>`+"\n"+
+		`<style></style>`+"\n"+
+		`<style></style>`+"\n"+
+		`<style></style>`+"\n"+
+		`<style>.e { fill: url(#g); }</style>`+"\n"+
+		`<circle filter="url(#f)" style="background: url(&#39;data:image/png;base64,AA&#39;)"></circle>`+"\n"+
+		`<rect></rect>`+"\n"+
+		`</svg>`, strings.ReplaceAll(string(logo.Data), "&#xA;", "\n"))
+	assert.Equal(t, logo.Data, logo.Thumb)
+
+	for _, invalid := range []string{
+		`<html><body></body></html>`,
+		`<svg><g></svg>`,
+		`<svg></svg><svg></svg>`,
+		`<svg>`,
+	} {
+		_, err := processLogo(strings.NewReader(invalid))
+		assert.NotNil(t, err, invalid)
+	}
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"gorm.io/gorm"
+)
+
+// MergeSecurities moves markets with prices, identifiers, aliases, events, taxonomies, tags,
+// holdings, portfolio securities and alerts of duplicate into canonical security and removes
+// duplicate. Prices of same market and date are resolved by preference, events of same date
+// and type, taxonomies of roots already classified and holdings are kept from canonical.
+// Missing attributes of canonical are taken from duplicate. UUID of duplicate is
+// redirected to canonical security.
+func (s *securityService) MergeSecurities(
+	canonicalUuid, duplicateUuid uuid.UUID,
+	prefer model.SecurityMergePreference,
+	user *model.User,
+) (*model.Security, error) {
+	if !prefer.IsValid() {
+		return nil, errors.New("prefer must be canonical or duplicate")
+	}
+	if canonicalUuid == duplicateUuid {
+		return nil, errors.New("security cannot be merged into itself")
+	}
+
+	err := s.changeSecurities([]uuid.UUID{canonicalUuid, duplicateUuid}, user, model.SecurityChangeActionMerge,
+		func(tx *gorm.DB) error {
+			var count int64
+			if err := tx.Model(&db.Security{}).
+				Where("uuid IN ?", []uuid.UUID{canonicalUuid, duplicateUuid}).
+				Count(&count).Error; err != nil {
+				panic(err)
+			}
+			if count != 2 {
+				return model.ErrNotFound
+			}
+
+			mergeSecurityAttributes(tx, canonicalUuid, duplicateUuid)
+			mergeSecurityMarkets(tx, canonicalUuid, duplicateUuid, prefer)
+			mergeSecurityRelations(tx, canonicalUuid, duplicateUuid)
+
+			// Redirect duplicate and securities previously merged into duplicate
+			if err := tx.Model(&db.SecurityRedirect{}).
+				Where("security_uuid = ?", duplicateUuid).
+				Update("security_uuid", canonicalUuid).Error; err != nil {
+				panic(err)
+			}
+			if err := tx.Create(&db.SecurityRedirect{OldUUID: duplicateUuid, SecurityUUID: canonicalUuid}).Error; err != nil {
+				panic(err)
+			}
+
+			if err := tx.Delete(&db.Security{}, "uuid = ?", duplicateUuid).Error; err != nil {
+				panic(err)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSecurityByUUID(canonicalUuid)
+}
+
+// mergeSecurityAttributes sets attributes of canonical which are null to attributes of duplicate
+func mergeSecurityAttributes(tx *gorm.DB, canonicalUuid, duplicateUuid uuid.UUID) {
+	if err := tx.Exec(`UPDATE securities c SET
+			name = COALESCE(c.name, d.name),
+			isin = COALESCE(c.isin, d.isin),
+			wkn = COALESCE(c.wkn, d.wkn),
+			security_type = COALESCE(c.security_type, d.security_type),
+			extras = d.extras || jsonb_strip_nulls(c.extras)
+		FROM securities d
+		WHERE c.uuid = ? AND d.uuid = ?`, canonicalUuid, duplicateUuid).Error; err != nil {
+		panic(err)
+	}
+}
+
+// mergeSecurityMarkets moves markets of duplicate which canonical does not have,
+// prices of markets both have are merged into market of canonical
+func mergeSecurityMarkets(tx *gorm.DB, canonicalUuid, duplicateUuid uuid.UUID, prefer model.SecurityMergePreference) {
+	var pairs []struct {
+		CanonicalID uint
+		DuplicateID uint
+	}
+	if err := tx.Table("securities_markets c").
+		Select("c.id AS canonical_id, d.id AS duplicate_id").
+		Joins("INNER JOIN securities_markets d ON d.market_code = c.market_code").
+		Where("c.security_uuid = ? AND d.security_uuid = ?", canonicalUuid, duplicateUuid).
+		Scan(&pairs).Error; err != nil {
+		panic(err)
+	}
+
+	onConflict := "DO NOTHING"
+	if prefer == model.SecurityMergePreferenceDuplicate {
+		onConflict = `DO UPDATE SET close = EXCLUDED.close, open = EXCLUDED.open,
+			high = EXCLUDED.high, low = EXCLUDED.low, volume = EXCLUDED.volume`
+	}
+
+	for _, p := range pairs {
+		if err := tx.Exec(`INSERT INTO securities_markets_prices
+				(security_market_id, date, close, open, high, low, volume)
+			SELECT ?, date, close, open, high, low, volume
+			FROM securities_markets_prices WHERE security_market_id = ?
+			ON CONFLICT (security_market_id, date) `+onConflict,
+			p.CanonicalID, p.DuplicateID).Error; err != nil {
+			panic(err)
+		}
+		// Symbol of canonical market is kept if set
+		if err := tx.Exec(`UPDATE securities_markets c SET symbol = COALESCE(c.symbol, d.symbol)
+			FROM securities_markets d WHERE c.id = ? AND d.id = ?`, p.CanonicalID, p.DuplicateID).Error; err != nil {
+			panic(err)
+		}
+		if err := tx.Delete(&db.SecurityMarket{}, p.DuplicateID).Error; err != nil {
+			panic(err)
+		}
+		if err := updatePriceDates(tx, p.CanonicalID); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := tx.Model(&db.SecurityMarket{}).
+		Where("security_uuid = ?", duplicateUuid).
+		Update("security_uuid", canonicalUuid).Error; err != nil {
+		panic(err)
+	}
+}
+
+// mergeSecurityRelations moves identifiers, aliases, events, taxonomies, tags, holdings,
+// portfolio securities and alerts of duplicate to canonical
+func mergeSecurityRelations(tx *gorm.DB, canonicalUuid, duplicateUuid uuid.UUID) {
+	statements := []string{
+		// Ticker of canonical is kept if it has one for market
+		`INSERT INTO securities_identifiers (security_uuid, scheme, mic, value)
+		SELECT @canonical, d.scheme, d.mic, d.value FROM securities_identifiers d
+		WHERE d.security_uuid = @duplicate AND NOT (d.scheme = 'ticker' AND EXISTS (
+			SELECT 1 FROM securities_identifiers c
+			WHERE c.security_uuid = @canonical AND c.scheme = 'ticker' AND c.mic = d.mic))
+		ON CONFLICT DO NOTHING`,
+
+		// ISIN and WKN of duplicate remain searchable, attributes are merged already
+		`INSERT INTO securities_identifiers (security_uuid, scheme, value)
+		SELECT @canonical, i.scheme, UPPER(i.value)
+		FROM securities c, securities d,
+		LATERAL (VALUES ('isin', d.isin, c.isin), ('wkn', d.wkn, c.wkn)) AS i(scheme, value, canonical_value)
+		WHERE c.uuid = @canonical AND d.uuid = @duplicate
+			AND i.value IS NOT NULL AND UPPER(i.value) IS DISTINCT FROM UPPER(i.canonical_value)
+		ON CONFLICT DO NOTHING`,
+
+		`INSERT INTO securities_aliases (security_uuid, kind, name)
+		SELECT @canonical, kind, name FROM securities_aliases WHERE security_uuid = @duplicate
+		ON CONFLICT DO NOTHING`,
+
+		// Name of duplicate remains searchable
+		`INSERT INTO securities_aliases (security_uuid, kind, name)
+		SELECT @canonical, 'other', d.name
+		FROM securities c, securities d
+		WHERE c.uuid = @canonical AND d.uuid = @duplicate AND TRIM(d.name) <> ''
+			AND LOWER(d.name) IS DISTINCT FROM LOWER(c.name)
+		ON CONFLICT DO NOTHING`,
+
+		`UPDATE events e SET security_uuid = @canonical
+		WHERE e.security_uuid = @duplicate AND NOT EXISTS (
+			SELECT 1 FROM events c
+			WHERE c.security_uuid = @canonical AND c.date = e.date AND c.type = e.type)`,
+
+		// Weights of taxonomies within root must not be mixed
+		`UPDATE securities_taxonomies st SET security_uuid = @canonical
+		FROM taxonomies t
+		WHERE st.taxonomy_uuid = t.uuid AND st.security_uuid = @duplicate AND NOT EXISTS (
+			SELECT 1 FROM securities_taxonomies cst
+			INNER JOIN taxonomies ct ON ct.uuid = cst.taxonomy_uuid
+			WHERE cst.security_uuid = @canonical AND ct.root_uuid = t.root_uuid)`,
+
+		`INSERT INTO securities_tags (security_uuid, tag_uuid)
+		SELECT @canonical, tag_uuid FROM securities_tags WHERE security_uuid = @duplicate
+		ON CONFLICT DO NOTHING`,
+
+		// Holdings of canonical are kept if it has any
+		`UPDATE securities_holdings SET security_uuid = @canonical
+		WHERE security_uuid = @duplicate AND NOT EXISTS (
+			SELECT 1 FROM securities_holdings WHERE security_uuid = @canonical)`,
+
+		`UPDATE securities_holdings SET constituent_uuid = @canonical
+		WHERE constituent_uuid = @duplicate AND security_uuid <> @canonical`,
+
+		`UPDATE portfolios_securities SET security_uuid = @canonical WHERE security_uuid = @duplicate`,
+
+		`UPDATE alerts SET security_uuid = @canonical WHERE security_uuid = @duplicate`,
+	}
+
+	args := map[string]interface{}{"canonical": canonicalUuid, "duplicate": duplicateUuid}
+	for _, sql := range statements {
+		if err := tx.Exec(sql, args).Error; err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ResolveSecurityUUID returns UUID of security into which security was merged,
+// or UUID itself if there is no redirect
+func (s *securityService) ResolveSecurityUUID(securityUuid uuid.UUID) uuid.UUID {
+	var redirect db.SecurityRedirect
+	result := s.DB.Limit(1).Find(&redirect, "old_uuid = ?", securityUuid)
+	if result.Error != nil {
+		panic(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return securityUuid
+	}
+	return redirect.SecurityUUID
+}
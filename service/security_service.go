@@ -1,55 +1,54 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/portfolio-report/pr-api/db"
 	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs/calendar"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type securityService struct {
-	DB            *gorm.DB
-	s3client      s3.Client
-	logoBucket    string
-	logoBucketURL string
+	DB           *gorm.DB
+	Validate     *validator.Validate
+	LogoStore    model.BlobStore
+	LogoBaseURL  string
+	CacheService model.CacheService
 }
 
-// NewSecurityService creates and returns new security service
-func NewSecurityService(c *Config, db *gorm.DB) model.SecurityService {
-
-	// Create S3 client
-	creds := credentials.NewStaticCredentialsProvider(c.AwsAccessKeyID, c.AwsSecretAccessKey, "")
-	cfg, err := config.LoadDefaultConfig(context.TODO(), config.WithCredentialsProvider(creds), config.WithRegion(c.AwsRegion))
-	if err != nil {
-		panic(err)
-	}
-	s3client := s3.NewFromConfig(cfg)
-
+// NewSecurityService creates and returns new security service, logos cannot be
+// updated without logoStore, but URLs of existing logos are built with logoBaseURL
+func NewSecurityService(
+	db *gorm.DB, validate *validator.Validate, logoStore model.BlobStore, logoBaseURL string,
+	cacheService model.CacheService,
+) model.SecurityService {
 	return &securityService{
-		DB:            db,
-		s3client:      *s3client,
-		logoBucket:    c.AwsLogoBucket,
-		logoBucketURL: c.AwsLogoBucketURL,
+		DB:           db,
+		Validate:     validate,
+		LogoStore:    logoStore,
+		LogoBaseURL:  logoBaseURL,
+		CacheService: cacheService,
 	}
 }
 
 // GetSecurityByUUID returns security idenfitied by UUID
 func (s *securityService) GetSecurityByUUID(uuid uuid.UUID) (*model.Security, error) {
 	var security db.Security
-	if err := s.DB.Take(&security, "uuid = ?", uuid).Error; err != nil {
+	if err := s.DB.Scopes(db.PreloadIdentifiers, db.PreloadAliases).Take(&security, "uuid = ?", uuid).Error; err != nil {
 		return nil, err
 	}
 	return s.modelFromDb(security), nil
@@ -66,7 +65,9 @@ func (s *securityService) GetSecuritiesByTag(tag string) []*model.Security {
 	}
 
 	var dbSecurities []db.Security
-	if err := s.DB.Model(&dbTag).Association("Securities").Find(&dbSecurities); err != nil {
+	if err := s.DB.Scopes(db.PreloadIdentifiers, db.PreloadAliases).
+		Where("uuid IN (SELECT security_uuid FROM securities_tags WHERE tag_uuid = ?)", dbTag.UUID).
+		Find(&dbSecurities).Error; err != nil {
 		panic(err)
 	}
 
@@ -89,232 +90,346 @@ func (s *securityService) GetEventsOfSecurity(security *model.Security) []*model
 }
 
 // CreateSecurity create security
-func (s *securityService) CreateSecurity(input *model.SecurityInput) (*model.Security, error) {
+func (s *securityService) CreateSecurity(input *model.SecurityInput, user *model.User) (*model.Security, error) {
+	if err := normalizeSecurityCodes(s.Validate, input, nil); err != nil {
+		return nil, err
+	}
+	identifiers, err := securityIdentifiersFromInput(s.Validate, input, nil)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := normalizeSecurityAliases(input.Aliases)
+	if err != nil {
+		return nil, err
+	}
+
 	security := db.Security{
 		UUID:         uuid.New(),
 		Name:         input.Name,
 		Isin:         input.Isin,
 		Wkn:          input.Wkn,
 		SecurityType: input.SecurityType,
-		SymbolXfra:   input.SymbolXfra,
-		SymbolXnas:   input.SymbolXnas,
-		SymbolXnys:   input.SymbolXnys,
 	}
 
-	err := s.DB.Clauses(clause.Returning{}).Create(&security).Error
+	err = s.changeSecurities([]uuid.UUID{security.UUID}, user, model.SecurityChangeActionCreate,
+		func(tx *gorm.DB) error {
+			if err := tx.Clauses(clause.Returning{}).Create(&security).Error; err != nil {
+				panic(err)
+			}
+			replaceSecurityAliases(tx, security.UUID, aliases)
+			return replaceSecurityIdentifiers(tx, security.UUID, identifiers)
+		})
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	return s.modelFromDb(security), nil
+	return s.GetSecurityByUUID(security.UUID)
 }
 
-// UpdateSecurity stores all attributes of input (incl. nil values)
-func (s *securityService) UpdateSecurity(uuid uuid.UUID, input *model.SecurityInput) (*model.Security, error) {
-	security := db.Security{UUID: uuid}
-	err := s.DB.Model(&security).
-		Clauses(clause.Returning{}).
-		Updates(map[string]interface{}{
-			"Name":         input.Name,
-			"Isin":         input.Isin,
-			"Wkn":          input.Wkn,
-			"SecurityType": input.SecurityType,
-			"SymbolXfra":   input.SymbolXfra,
-			"SymbolXnas":   input.SymbolXnas,
-			"SymbolXnys":   input.SymbolXnys,
-		}).Error
+// UpdateSecurity stores all attributes of input (incl. nil values),
+// identifiers and aliases of security are replaced by those of input.
+// Only codes which are changed by input are validated.
+func (s *securityService) UpdateSecurity(securityUuid uuid.UUID, input *model.SecurityInput, user *model.User) (*model.Security, error) {
+	previous, err := s.GetSecurityByUUID(securityUuid)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	if err := normalizeSecurityCodes(s.Validate, input, previous); err != nil {
+		return nil, err
+	}
+	identifiers, err := securityIdentifiersFromInput(s.Validate, input, previous)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := normalizeSecurityAliases(input.Aliases)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.modelFromDb(security), nil
+	security := db.Security{UUID: securityUuid}
+	err = s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionUpdate,
+		func(tx *gorm.DB) error {
+			err := tx.Model(&security).
+				Updates(map[string]interface{}{
+					"Name":         input.Name,
+					"Isin":         input.Isin,
+					"Wkn":          input.Wkn,
+					"SecurityType": input.SecurityType,
+				}).Error
+			if err != nil {
+				panic(err)
+			}
+			replaceSecurityAliases(tx, securityUuid, aliases)
+			return replaceSecurityIdentifiers(tx, securityUuid, identifiers)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSecurityByUUID(securityUuid)
 }
 
 // DeleteSecurity removes security
-func (s *securityService) DeleteSecurity(uuid uuid.UUID) (*model.Security, error) {
+func (s *securityService) DeleteSecurity(securityUuid uuid.UUID, user *model.User) (*model.Security, error) {
 	var security db.Security
-	result := s.DB.Clauses(clause.Returning{}).Delete(&security, "uuid = ?", uuid)
-	if err := result.Error; err != nil {
-		panic(err)
-	}
-	if result.RowsAffected == 0 {
-		return nil, model.ErrNotFound
+	err := s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionDelete,
+		func(tx *gorm.DB) error {
+			err := tx.Scopes(db.PreloadIdentifiers, db.PreloadAliases).Take(&security, "uuid = ?", securityUuid).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.ErrNotFound
+			}
+			if err != nil {
+				panic(err)
+			}
+			if err := tx.Delete(&security).Error; err != nil {
+				panic(err)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
 	}
 	return s.modelFromDb(security), nil
 }
 
-// UpdateLogo updates logo of security
-func (s *securityService) UpdateLogo(securityUuid uuid.UUID, logo io.Reader, extension string) (string, error) {
+// ErrLogoStoreUnavailable is returned if logos cannot be stored
+var ErrLogoStoreUnavailable = errors.New("logo store is not available")
+
+// UpdateLogo validates and normalizes logo, stores it with thumbnail and updates
+// security, previous logo is kept in store since it is referenced by history of security
+func (s *securityService) UpdateLogo(securityUuid uuid.UUID, logo io.Reader, user *model.User) (*model.SecurityLogo, error) {
 	var security db.Security
 	if err := s.DB.Take(&security, "uuid = ?", securityUuid).Error; err != nil {
-		return "", err
+		return nil, err
 	}
 
-	oldLogoPath := s.logoUrlRelFromExtras(security.Extras)
+	if s.LogoStore == nil {
+		return nil, ErrLogoStoreUnavailable
+	}
 
-	// Create random file name
-	logoUuid := uuid.New()
-	logoPath := logoUuid.String() + extension
-	logoAbsPath := s.logoBucketURL + logoPath
-
-	// Upload file to S3
-	_, err := s.s3client.PutObject(context.TODO(), &s3.PutObjectInput{
-		Bucket: aws.String(s.logoBucket),
-		Key:    aws.String(logoPath),
-		Body:   logo,
-	})
+	img, err := processLogo(logo)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	// Update security with new URL
-	err = s.DB.Exec(`UPDATE securities SET extras = (jsonb_set(extras,'{"logoUrl"}', to_jsonb($1::text), true)) WHERE uuid=$2`, logoPath, securityUuid).Error
-	if err != nil {
-		panic(err)
+	// Create random file names
+	logoUuid := uuid.New()
+	logoPath := logoUuid.String() + img.Extension
+	logoThumbPath := logoUuid.String() + "_thumb" + img.Extension
+
+	// Upload files to store
+	meta := model.BlobMeta{ContentType: img.ContentType, CacheControl: logoCacheControl}
+	if err := s.LogoStore.Put(context.TODO(), logoPath, bytes.NewReader(img.Data), meta); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLogoStoreUnavailable, err)
+	}
+	if err := s.LogoStore.Put(context.TODO(), logoThumbPath, bytes.NewReader(img.Thumb), meta); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLogoStoreUnavailable, err)
 	}
 
-	// Delete old logo from S3
-	if oldLogoPath != nil {
-		_, err = s.s3client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
-			Bucket: aws.String(s.logoBucket),
-			Key:    oldLogoPath,
+	// Update security with new URLs
+	err = s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionUpdateLogo,
+		func(tx *gorm.DB) error {
+			err := tx.Exec(`UPDATE securities SET extras = extras || jsonb_build_object('logoUrl', $1::text, 'logoThumbUrl', $2::text) WHERE uuid=$3`,
+				logoPath, logoThumbPath, securityUuid).Error
+			if err != nil {
+				panic(err)
+			}
+			return nil
 		})
-		if err != nil {
-			panic(err)
-		}
+	if err != nil {
+		return nil, err
 	}
 
-	return logoAbsPath, nil
+	logoUrl, logoThumbUrl := s.LogoStore.URL(logoPath), s.LogoStore.URL(logoThumbPath)
+	return &model.SecurityLogo{LogoURL: &logoUrl, LogoThumbURL: &logoThumbUrl}, nil
 }
 
-// DeleteLogo removes logo of security
-func (s *securityService) DeleteLogo(securityUuid uuid.UUID) error {
+// DeleteLogo removes logo from security, logo is kept in store
+// since it is referenced by history of security
+func (s *securityService) DeleteLogo(securityUuid uuid.UUID, user *model.User) error {
 	var security db.Security
 	if err := s.DB.Take(&security, "uuid = ?", securityUuid).Error; err != nil {
 		return err
 	}
 
-	logoPath := s.logoUrlRelFromExtras(security.Extras)
+	// Set logoUrl and logoThumbUrl to null
+	return s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionDeleteLogo,
+		func(tx *gorm.DB) error {
+			err := tx.Exec(`UPDATE securities SET extras = extras || '{"logoUrl":null,"logoThumbUrl":null}'::jsonb WHERE uuid=$1`, securityUuid).Error
+			if err != nil {
+				panic(err)
+			}
+			return nil
+		})
+}
 
-	// Set logoUrl to null
-	err := s.DB.Exec(`UPDATE securities SET extras = (jsonb_set(extras,'{"logoUrl"}', 'null', true)) WHERE uuid=$1`, securityUuid).Error
-	if err != nil {
-		panic(err)
-	}
+// UpdateSecurityMarket creates market of security or updates its attributes,
+// new markets have updatePrices enabled by default
+func (s *securityService) UpdateSecurityMarket(
+	securityUuid uuid.UUID, marketCode string, input *model.SecurityMarketInput, user *model.User,
+) (*model.SecurityMarket, error) {
+	var market db.SecurityMarket
+	err := s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionUpdateMarket,
+		func(tx *gorm.DB) error {
+			err := tx.
+				Attrs(db.SecurityMarket{UpdatePrices: true}).
+				FirstOrInit(&market, db.SecurityMarket{SecurityUUID: securityUuid.String(), MarketCode: marketCode}).
+				Error
+			if err != nil {
+				panic(err)
+			}
 
-	// Delete logo from S3
-	if logoPath != nil {
-		_, err = s.s3client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
-			Bucket: aws.String(s.logoBucket),
-			Key:    logoPath,
+			if input.CurrencyCode != nil {
+				market.CurrencyCode = *input.CurrencyCode
+			}
+			if input.Symbol != nil {
+				market.Symbol = input.Symbol
+			}
+			if input.UpdatePrices != nil {
+				market.UpdatePrices = *input.UpdatePrices
+			}
+
+			if err := tx.Save(&market).Error; err != nil {
+				return constraintError(err)
+			}
+			return nil
 		})
-		if err != nil {
-			panic(err)
-		}
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	return s.securityMarketModelFromDb(market), nil
 }
 
 // DeleteSecurityMarket removes market of security
-func (s *securityService) DeleteSecurityMarket(securityUuid uuid.UUID, marketCode string) (*model.SecurityMarket, error) {
+func (s *securityService) DeleteSecurityMarket(securityUuid uuid.UUID, marketCode string, user *model.User) (*model.SecurityMarket, error) {
 	var market db.SecurityMarket
-	result := s.DB.
-		Clauses(clause.Returning{}).
-		Delete(&market, "security_uuid = ? AND market_code = ?", securityUuid, marketCode)
-	if err := result.Error; err != nil {
-		panic(err)
-	}
-	if result.RowsAffected == 0 {
-		return nil, model.ErrNotFound
+	err := s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionDeleteMarket,
+		func(tx *gorm.DB) error {
+			result := tx.
+				Clauses(clause.Returning{}).
+				Delete(&market, "security_uuid = ? AND market_code = ?", securityUuid, marketCode)
+			if err := result.Error; err != nil {
+				panic(err)
+			}
+			if result.RowsAffected == 0 {
+				return model.ErrNotFound
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
 	}
 	return s.securityMarketModelFromDb(market), nil
 }
 
 // UpdateSecurityTaxonomies creates/updates/deletes taxonomies of security
 func (s *securityService) UpdateSecurityTaxonomies(
-	securityUuid, rootTaxonomyUuid uuid.UUID, inputs []*model.SecurityTaxonomyInput,
+	securityUuid, rootTaxonomyUuid uuid.UUID, inputs []*model.SecurityTaxonomyInput, user *model.User,
 ) (
 	[]*model.SecurityTaxonomy, error,
 ) {
-	// Remove securityTaxonomies of rootTaxonomy not in inputs
-	secTaxonomyUuids := make([]uuid.UUID, len(inputs))
-	for i := range inputs {
-		secTaxonomyUuids[i] = inputs[i].TaxonomyUUID
-	}
-	var err error
-	if len(secTaxonomyUuids) == 0 {
-		err = s.DB.Exec("DELETE FROM securities_taxonomies st "+
-			"USING taxonomies t "+
-			"WHERE st.taxonomy_uuid = t.uuid"+
-			" AND st.security_uuid = ?"+
-			" AND t.root_uuid = ?", securityUuid, rootTaxonomyUuid).
-			Error
-	} else {
-		err = s.DB.Exec("DELETE FROM securities_taxonomies st "+
-			"USING taxonomies t "+
-			"WHERE st.taxonomy_uuid = t.uuid"+
-			" AND st.security_uuid = ?"+
-			" AND t.root_uuid = ?"+
-			" AND st.taxonomy_uuid NOT IN ?", securityUuid, rootTaxonomyUuid, secTaxonomyUuids).
-			Error
-	}
-	if err != nil {
-		panic(err)
-	}
-
-	// Upsert all security taxonomies in input
 	upsert := make([]db.SecurityTaxonomy, len(inputs))
-	for i := range inputs {
-		upsert[i].SecurityUUID = securityUuid
-		upsert[i].TaxonomyUUID = inputs[i].TaxonomyUUID
-		upsert[i].Weight = inputs[i].Weight
-	}
-	if len(upsert) > 0 {
-		if err := s.DB.Clauses(clause.OnConflict{UpdateAll: true}).Create(&upsert).Error; err != nil {
-			panic(err)
-		}
+	err := s.changeSecurities([]uuid.UUID{securityUuid}, user, model.SecurityChangeActionUpdateTaxonomies,
+		func(tx *gorm.DB) error {
+			// Remove securityTaxonomies of rootTaxonomy not in inputs
+			secTaxonomyUuids := make([]uuid.UUID, len(inputs))
+			for i := range inputs {
+				secTaxonomyUuids[i] = inputs[i].TaxonomyUUID
+			}
+			var err error
+			if len(secTaxonomyUuids) == 0 {
+				err = tx.Exec("DELETE FROM securities_taxonomies st "+
+					"USING taxonomies t "+
+					"WHERE st.taxonomy_uuid = t.uuid"+
+					" AND st.security_uuid = ?"+
+					" AND t.root_uuid = ?", securityUuid, rootTaxonomyUuid).
+					Error
+			} else {
+				err = tx.Exec("DELETE FROM securities_taxonomies st "+
+					"USING taxonomies t "+
+					"WHERE st.taxonomy_uuid = t.uuid"+
+					" AND st.security_uuid = ?"+
+					" AND t.root_uuid = ?"+
+					" AND st.taxonomy_uuid NOT IN ?", securityUuid, rootTaxonomyUuid, secTaxonomyUuids).
+					Error
+			}
+			if err != nil {
+				panic(err)
+			}
+
+			// Upsert all security taxonomies in input
+			for i := range inputs {
+				upsert[i].SecurityUUID = securityUuid
+				upsert[i].TaxonomyUUID = inputs[i].TaxonomyUUID
+				upsert[i].Weight = inputs[i].Weight
+			}
+			if len(upsert) > 0 {
+				if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&upsert).Error; err != nil {
+					panic(err)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
 	return s.securityTaxonomiesModelFromDb(upsert), nil
 }
 
 // UpsertTag creates/updates tag
-func (s *securityService) UpsertTag(name string, securityUuids []uuid.UUID) ([]*model.Security, error) {
-
-	// Get or create tag
-	var tag db.Tag
-	if err := s.DB.
-		Where("LOWER(name) = LOWER(?)", name).
-		Attrs(db.Tag{UUID: uuid.New()}).
-		FirstOrCreate(&tag, db.Tag{Name: name}).Error; err != nil {
+func (s *securityService) UpsertTag(name string, securityUuids []uuid.UUID, user *model.User) ([]*model.Security, error) {
+	// Securities currently associated with tag are changed if removed
+	var associatedUuids []uuid.UUID
+	if err := s.DB.Table("securities_tags st").
+		Joins("INNER JOIN tags t ON t.uuid = st.tag_uuid").
+		Where("LOWER(t.name) = LOWER(?)", name).
+		Pluck("st.security_uuid", &associatedUuids).Error; err != nil {
 		panic(err)
 	}
 
-	// Delete removed associations
-	if err := s.DB.Delete(&db.SecurityTag{}, "tag_uuid = ? AND security_uuid NOT IN ?", tag.UUID, securityUuids).Error; err != nil {
-		panic(err)
-	}
+	var tag db.Tag
+	err := s.changeSecurities(append(associatedUuids, securityUuids...), user, model.SecurityChangeActionUpdateTag,
+		func(tx *gorm.DB) error {
+			// Get or create tag
+			if err := tx.
+				Where("LOWER(name) = LOWER(?)", name).
+				Attrs(db.Tag{UUID: uuid.New()}).
+				FirstOrCreate(&tag, db.Tag{Name: name}).Error; err != nil {
+				panic(err)
+			}
 
-	// Create new associations
-	upsert := make([]db.SecurityTag, len(securityUuids))
-	for i := range securityUuids {
-		upsert[i].TagUUID = tag.UUID
-		upsert[i].SecurityUUID = securityUuids[i]
-	}
-	if len(upsert) > 0 {
-		if err := s.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&upsert).Error; err != nil {
-			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
-				return nil, fmt.Errorf("data violates constraint " + pqErr.Constraint)
+			// Delete removed associations
+			if err := tx.Delete(&db.SecurityTag{}, "tag_uuid = ? AND security_uuid NOT IN ?", tag.UUID, securityUuids).Error; err != nil {
+				panic(err)
 			}
 
-			panic(err)
-		}
+			// Create new associations
+			upsert := make([]db.SecurityTag, len(securityUuids))
+			for i := range securityUuids {
+				upsert[i].TagUUID = tag.UUID
+				upsert[i].SecurityUUID = securityUuids[i]
+			}
+			if len(upsert) > 0 {
+				if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&upsert).Error; err != nil {
+					if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+						return fmt.Errorf("data violates constraint " + pqErr.Constraint)
+					}
+
+					panic(err)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
 	// Get associated securities
 	var dbSecurities []db.Security
-	if err := s.DB.Model(&tag).Association("Securities").Find(&dbSecurities); err != nil {
+	if err := s.DB.Scopes(db.PreloadIdentifiers, db.PreloadAliases).
+		Where("uuid IN (SELECT security_uuid FROM securities_tags WHERE tag_uuid = ?)", tag.UUID).
+		Find(&dbSecurities).Error; err != nil {
 		panic(err)
 	}
 
@@ -327,43 +442,181 @@ func (s *securityService) UpsertTag(name string, securityUuids []uuid.UUID) ([]*
 }
 
 // DeleteTag removes tag
-func (s *securityService) DeleteTag(name string) {
-	if err := s.DB.Where("LOWER(name) = LOWER(?)", name).Delete(&db.Tag{}).Error; err != nil {
+func (s *securityService) DeleteTag(name string, user *model.User) {
+	var associatedUuids []uuid.UUID
+	if err := s.DB.Table("securities_tags st").
+		Joins("INNER JOIN tags t ON t.uuid = st.tag_uuid").
+		Where("LOWER(t.name) = LOWER(?)", name).
+		Pluck("st.security_uuid", &associatedUuids).Error; err != nil {
 		panic(err)
 	}
+
+	err := s.changeSecurities(associatedUuids, user, model.SecurityChangeActionDeleteTag,
+		func(tx *gorm.DB) error {
+			if err := tx.Where("LOWER(name) = LOWER(?)", name).Delete(&db.Tag{}).Error; err != nil {
+				panic(err)
+			}
+			return nil
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// gapCandidatesBatchSize limits number of candidates of gaps loaded at once
+const gapCandidatesBatchSize = 1000
+
+// FindGapsInPrices finds gaps in price time series, duration of gaps is counted
+// in trading days according to calendar of market. Gaps are ordered by security,
+// market and date and can be paginated by offset or cursor. Total count requires
+// a scan of all prices and is only determined if requested.
+func (s *securityService) FindGapsInPrices(q *model.PriceGapsQuery) (*model.PriceGaps, error) {
+	var after *model.PriceGap
+	if q.Cursor != "" {
+		var err error
+		if after, err = decodeGapCursor(q.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	calendars := marketCalendars(s.DB)
+
+	result := &model.PriceGaps{Entries: []*model.PriceGap{}}
+
+	if q.TotalCount {
+		totalCount := 0
+		s.scanGapsInPrices(q, nil, calendars, gapCandidatesBatchSize, func(*model.PriceGap) bool {
+			totalCount++
+			return true
+		})
+		result.TotalCount = &totalCount
+	}
+
+	// One more gap than requested tells whether there is a next page
+	batchSize := q.Skip + q.Limit + 1
+	if batchSize > gapCandidatesBatchSize {
+		batchSize = gapCandidatesBatchSize
+	}
+
+	skip := q.Skip
+	s.scanGapsInPrices(q, after, calendars, batchSize, func(g *model.PriceGap) bool {
+		if skip > 0 {
+			skip--
+			return true
+		}
+		if len(result.Entries) == q.Limit {
+			cursor := encodeGapCursor(result.Entries[len(result.Entries)-1])
+			result.NextCursor = &cursor
+			return false
+		}
+		result.Entries = append(result.Entries, g)
+		return true
+	})
+
+	return result, nil
 }
 
-// FindGapsInPrices finds gaps in price time series
-func (s *securityService) FindGapsInPrices(minDuration, maxResults int) []map[string]interface{} {
-	type gap struct {
-		SecurityUUID uuid.UUID  `json:"securityUuid"`
-		MarketCode   string     `json:"marketCode"`
-		FromDate     model.Date `json:"fromDate"`
-		ToDate       model.Date `json:"toDate"`
-		Duration     int        `json:"duration"`
-	}
-
-	var gaps []gap
-	s.DB.Raw(`
-		SELECT
-	 		m.security_uuid, m.market_code, p.from_date, p.to_date, p.to_date - p.from_date + 1 AS duration
-		FROM
-			(SELECT
-				security_market_id,
-				date + 1 AS from_date,
-				LEAD(date, 1) OVER (PARTITION BY security_market_id ORDER BY date) - 1 AS to_date
-			FROM securities_markets_prices) p
-		LEFT JOIN securities_markets m ON (m.id = p.security_market_id)
-		WHERE to_date - from_date + 1 >= ?
-		LIMIT ?`,
-		minDuration, maxResults).Scan(&gaps)
-
-	// Convert structs to maps
-	var maps []map[string]interface{}
-	bytes, _ := json.Marshal(gaps)
-	json.Unmarshal(bytes, &maps)
-
-	return maps
+// scanGapsInPrices passes gaps after gap (if not nil) in order to fn until fn returns false.
+// Gaps of calendar days are loaded in batches and those shorter than minimum
+// duration in trading days are skipped.
+func (s *securityService) scanGapsInPrices(
+	q *model.PriceGapsQuery,
+	after *model.PriceGap,
+	calendars map[string]*calendar.Calendar,
+	batchSize int,
+	fn func(*model.PriceGap) bool,
+) {
+	for {
+		query := s.DB.Table("securities_markets m").
+			Select("m.security_uuid, m.market_code, p.from_date, p.to_date").
+			Joins(`CROSS JOIN LATERAL (SELECT
+					date + 1 AS from_date,
+					LEAD(date, 1) OVER (ORDER BY date) - 1 AS to_date
+				FROM securities_markets_prices
+				WHERE security_market_id = m.id) p`).
+			// Gaps of calendar days are candidates, since trading days never exceed them
+			Where("p.to_date - p.from_date + 1 >= ?", q.MinDuration).
+			Order(`m.security_uuid, m.market_code COLLATE "C", p.from_date`).
+			Limit(batchSize)
+
+		if after != nil {
+			query = query.
+				// Skips markets before cursor without looking at their prices
+				Where(`(m.security_uuid, m.market_code COLLATE "C") >= (?::uuid, ? COLLATE "C")`,
+					after.SecurityUUID, after.MarketCode).
+				Where(`(m.security_uuid, m.market_code COLLATE "C", p.from_date) > (?::uuid, ? COLLATE "C", ?::date)`,
+					after.SecurityUUID, after.MarketCode, after.FromDate.String())
+		}
+		if q.MarketCode != "" {
+			query = query.Where("m.market_code = ?", q.MarketCode)
+		}
+		if q.SecurityType != "" {
+			query = query.
+				Joins("INNER JOIN securities s ON s.uuid = m.security_uuid").
+				Where("s.security_type = ?", q.SecurityType)
+		}
+
+		var candidates []model.PriceGap
+		if err := query.Scan(&candidates).Error; err != nil {
+			panic(err)
+		}
+
+		for i := range candidates {
+			g := candidates[i]
+			days := calendars[g.MarketCode].TradingDays(g.FromDate.Time(), g.ToDate.Time())
+			if len(days) == 0 || len(days) < q.MinDuration {
+				continue
+			}
+
+			g.FromDate = model.Date(days[0])
+			g.ToDate = model.Date(days[len(days)-1])
+			g.Duration = len(days)
+			if !fn(&g) {
+				return
+			}
+		}
+
+		if len(candidates) < batchSize {
+			return
+		}
+		// Next batch continues after last candidate (not trimmed to trading days)
+		after = &candidates[len(candidates)-1]
+	}
+}
+
+// encodeGapCursor creates opaque cursor pointing to gap
+func encodeGapCursor(g *model.PriceGap) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(g.SecurityUUID.String() + "/" + g.FromDate.String() + "/" + g.MarketCode))
+}
+
+// decodeGapCursor parses cursor created by encodeGapCursor
+func decodeGapCursor(cursor string) (*model.PriceGap, error) {
+	invalid := errors.New("invalid cursor")
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+	parts := strings.SplitN(string(decoded), "/", 3)
+	if len(parts) != 3 {
+		return nil, invalid
+	}
+
+	securityUuid, err := uuid.Parse(parts[0])
+	if err != nil {
+		return nil, invalid
+	}
+	fromDate, err := time.Parse("2006-01-02", parts[1])
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &model.PriceGap{
+		SecurityUUID: securityUuid,
+		MarketCode:   parts[2],
+		FromDate:     model.Date(fromDate),
+	}, nil
 }
 
 // eventsModelFromDb converts list of events from database into model
@@ -405,38 +658,49 @@ func (*securityService) securityMarketModelFromDb(m db.SecurityMarket) *model.Se
 	}
 }
 
-// modelFromDb converts security from database into model
-func (*securityService) modelFromDb(s db.Security) *model.Security {
+// modelFromDb converts security from database into model, identifiers and aliases must be loaded
+func (s *securityService) modelFromDb(security db.Security) *model.Security {
+	logo := s.LogoFromExtras(security.Extras)
 	return &model.Security{
-		UUID:         s.UUID,
-		Name:         s.Name,
-		Isin:         s.Isin,
-		Wkn:          s.Wkn,
-		SecurityType: s.SecurityType,
-		SymbolXfra:   s.SymbolXfra,
-		SymbolXnas:   s.SymbolXnas,
-		SymbolXnys:   s.SymbolXnys,
+		UUID:         security.UUID,
+		Name:         security.Name,
+		Isin:         security.Isin,
+		Wkn:          security.Wkn,
+		SecurityType: security.SecurityType,
+		SymbolXfra:   security.Ticker("XFRA"),
+		SymbolXnas:   security.Ticker("XNAS"),
+		SymbolXnys:   security.Ticker("XNYS"),
+		LogoURL:      logo.LogoURL,
+		LogoThumbURL: logo.LogoThumbURL,
+		Identifiers:  securityIdentifiersModelFromDb(security.Identifiers),
+		Aliases:      securityAliasesModelFromDb(security.Aliases),
 	}
 }
 
-// logoUrlRelFromExtras returns relative URL of logo
-func (s *securityService) logoUrlRelFromExtras(extrasJson datatypes.JSON) *string {
+// LogoFromExtras returns absolute URLs of logo and thumbnail, logos
+// uploaded before thumbnails were introduced are used as thumbnail
+func (s *securityService) LogoFromExtras(extrasJson datatypes.JSON) model.SecurityLogo {
+	var logo model.SecurityLogo
+	if len(extrasJson) == 0 {
+		return logo
+	}
+
 	var extras struct {
-		LogoURL *string `json:"logoUrl"`
+		LogoURL      *string `json:"logoUrl"`
+		LogoThumbURL *string `json:"logoThumbUrl"`
 	}
 	err := json.Unmarshal(extrasJson, &extras)
 	if err != nil {
 		panic(err)
 	}
-	return extras.LogoURL
-}
 
-// LogoUrlFromExtras returns absolute URL of logo
-func (s *securityService) LogoUrlFromExtras(extrasJson datatypes.JSON) *string {
-	logoUrl := s.logoUrlRelFromExtras(extrasJson)
-	if logoUrl != nil {
-		logoUrl := s.logoBucketURL + *logoUrl
-		return &logoUrl
+	if extras.LogoURL == nil {
+		return logo
+	}
+	if extras.LogoThumbURL == nil {
+		extras.LogoThumbURL = extras.LogoURL
 	}
-	return nil
+	logoUrl, logoThumbUrl := s.LogoBaseURL+*extras.LogoURL, s.LogoBaseURL+*extras.LogoThumbURL
+	logo.LogoURL, logo.LogoThumbURL = &logoUrl, &logoThumbUrl
+	return logo
 }
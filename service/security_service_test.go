@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/db"
 	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/libs"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
@@ -27,7 +28,9 @@ func (s *SecurityServiceTestSuite) SetupSuite() {
 	s.db, err = db.InitDb(c.Db)
 	s.Nil(err)
 
-	service := NewSecurityService(c, s.db)
+	logoStore, err := NewLogoStore(c)
+	s.Nil(err)
+	service := NewSecurityService(s.db, libs.GetValidator(), logoStore, LogoBaseURL(c), NewCacheService(0))
 	var ok bool
 	s.service, ok = service.(*securityService)
 	s.True(ok)
@@ -45,7 +48,7 @@ func TestSecurityService(t *testing.T) {
 
 func (s *SecurityServiceTestSuite) TestSecurityLifecycle() {
 	// Create empty security
-	emptySec, err := s.service.CreateSecurity(&model.SecurityInput{})
+	emptySec, err := s.service.CreateSecurity(&model.SecurityInput{}, nil)
 	s.Nil(err)
 	s.NotNil(emptySec)
 
@@ -67,7 +70,7 @@ func (s *SecurityServiceTestSuite) TestSecurityLifecycle() {
 		newName := "Updated name"
 		security, err := s.service.UpdateSecurity(emptySec.UUID, &model.SecurityInput{
 			Name: &newName,
-		})
+		}, nil)
 		s.Nil(err)
 		s.Equal(newName, *security.Name)
 		s.Nil(security.SecurityType)
@@ -75,7 +78,7 @@ func (s *SecurityServiceTestSuite) TestSecurityLifecycle() {
 		newSecurityType := "secType"
 		security, err = s.service.UpdateSecurity(emptySec.UUID, &model.SecurityInput{
 			SecurityType: &newSecurityType,
-		})
+		}, nil)
 		s.Nil(err)
 		s.Nil(security.Name)
 		s.Equal(newSecurityType, *security.SecurityType)
@@ -83,13 +86,13 @@ func (s *SecurityServiceTestSuite) TestSecurityLifecycle() {
 
 	// Delete security
 	{
-		_, err := s.service.DeleteSecurity(emptySec.UUID)
+		_, err := s.service.DeleteSecurity(emptySec.UUID, nil)
 		s.Nil(err)
 	}
 
 	// Delete nonexistent security
 	{
-		_, err := s.service.DeleteSecurity(emptySec.UUID)
+		_, err := s.service.DeleteSecurity(emptySec.UUID, nil)
 		s.ErrorIs(err, model.ErrNotFound)
 	}
 }
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
 	"strings"
 	"time"
@@ -29,6 +32,16 @@ func NewUserService(db *gorm.DB) model.UserService {
 // because the username is used already
 var ErrUserExistsAlready = errors.New("user exists already")
 
+// ErrEmailVerificationPending indicates a verification mail has been sent recently
+var ErrEmailVerificationPending = errors.New("verification email has been sent recently")
+
+// ErrInvalidEmailVerificationToken indicates a wrong or outdated verification token
+var ErrInvalidEmailVerificationToken = errors.New("invalid verification token")
+
+// emailVerificationInterval is minimum time between verification mails of user,
+// so that users cannot send mails to arbitrary addresses repeatedly
+const emailVerificationInterval = 10 * time.Minute
+
 // modelFromDb converts user from database into model
 func (*userService) modelFromDb(u db.User) *model.User {
 	return &model.User{
@@ -36,6 +49,9 @@ func (*userService) modelFromDb(u db.User) *model.User {
 		Username:   u.Username,
 		IsAdmin:    u.IsAdmin,
 		LastSeenAt: time.Time(u.LastSeenAt).Format("2006-01-02"),
+
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
 	}
 }
 
@@ -117,6 +133,67 @@ func (s *userService) VerifyPassword(ctx context.Context, user *model.User, pass
 	return argon2.VerifyPassword(password, *dbUser.Password)
 }
 
+// UpdateEmail sets unverified email address of user and returns token
+// to be sent to the address for verification
+func (s *userService) UpdateEmail(ctx context.Context, user *model.User, email string) (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+	tokenHex := hex.EncodeToString(token)
+
+	result := s.DB.Model(&db.User{}).
+		Where("id = ?", user.ID).
+		Where("email_verification_sent_at IS NULL OR email_verification_sent_at < ?", time.Now().Add(-emailVerificationInterval)).
+		Updates(map[string]interface{}{
+			"email":                      strings.ToLower(email),
+			"email_verified":             false,
+			"email_verification_token":   tokenHex,
+			"email_verification_sent_at": time.Now(),
+		})
+	if err := result.Error; err != nil {
+		panic(err)
+	}
+	if result.RowsAffected == 0 {
+		return "", ErrEmailVerificationPending
+	}
+
+	return tokenHex, nil
+}
+
+// VerifyEmail marks email address of user as verified if token matches
+func (s *userService) VerifyEmail(ctx context.Context, user *model.User, token string) error {
+	var dbUser db.User
+	if err := s.DB.Take(&dbUser, user.ID).Error; err != nil {
+		panic(err)
+	}
+	if dbUser.EmailVerificationToken == nil ||
+		subtle.ConstantTimeCompare([]byte(*dbUser.EmailVerificationToken), []byte(token)) != 1 {
+		return ErrInvalidEmailVerificationToken
+	}
+
+	if err := s.DB.Model(&db.User{}).
+		Where("id = ?", user.ID).
+		Updates(map[string]interface{}{
+			"email_verified":           true,
+			"email_verification_token": nil,
+		}).Error; err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// CancelEmailVerification discards token of verification which could not be sent,
+// so that verification can be requested again right away
+func (s *userService) CancelEmailVerification(ctx context.Context, user *model.User, token string) error {
+	return s.DB.Model(&db.User{}).
+		Where("id = ? AND email_verification_token = ?", user.ID, token).
+		Updates(map[string]interface{}{
+			"email_verification_token":   nil,
+			"email_verification_sent_at": nil,
+		}).Error
+}
+
 // Delete removes user
 func (s *userService) Delete(id int) error {
 	return s.DB.Delete(db.User{}, "id = ?", id).Error
@@ -118,6 +118,44 @@ func (s *UserServiceTestSuite) TestUserLifecycle() {
 		s.True(time.Time(dbUser.LastSeenAt).After(time.Now().AddDate(0, 0, -1)))
 	}
 
+	// Update email, verification mails are throttled
+	var token string
+	{
+		token, err = s.service.UpdateEmail(context.TODO(), user, "Test@Example.com")
+		s.Nil(err)
+		s.Len(token, 64)
+		err = s.db.Take(&dbUser, "username = 'testuser'").Error
+		s.Nil(err)
+		s.Equal("test@example.com", *dbUser.Email)
+		s.False(dbUser.EmailVerified)
+
+		_, err = s.service.UpdateEmail(context.TODO(), user, "other@example.com")
+		s.ErrorIs(err, ErrEmailVerificationPending)
+
+		// Verification which could not be sent can be requested again
+		err = s.service.CancelEmailVerification(context.TODO(), user, token)
+		s.Nil(err)
+		token, err = s.service.UpdateEmail(context.TODO(), user, "Test@Example.com")
+		s.Nil(err)
+	}
+
+	// Verify email
+	{
+		err := s.service.VerifyEmail(context.TODO(), user, "wrong")
+		s.ErrorIs(err, ErrInvalidEmailVerificationToken)
+
+		err = s.service.VerifyEmail(context.TODO(), user, token)
+		s.Nil(err)
+		err = s.db.Take(&dbUser, "username = 'testuser'").Error
+		s.Nil(err)
+		s.True(dbUser.EmailVerified)
+		s.Nil(dbUser.EmailVerificationToken)
+
+		// Token is used once only
+		err = s.service.VerifyEmail(context.TODO(), user, token)
+		s.ErrorIs(err, ErrInvalidEmailVerificationToken)
+	}
+
 	// Delete user
 	{
 		err = s.service.Delete(user.ID)
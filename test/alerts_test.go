@@ -0,0 +1,164 @@
+package test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlerts(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TEST", Name: "Test market"})
+
+	a := assert.New(t)
+
+	var securityUuid string
+	var alertId string
+
+	// Create security with market
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/securities/", gin.H{"name": "Test name"}, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuid = body["uuid"].(string)
+
+		res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TEST",
+			gin.H{"currencyCode": "EUR"}, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	// GET /alerts/ -> empty
+	{
+		body, res := jsonbody[[]gin.H](
+			api("GET", "/alerts/", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(body, 0)
+	}
+
+	// POST /alerts/ requires verified email address of user
+	{
+		reqBody := gin.H{"securityUuid": securityUuid, "type": "dividend"}
+		res := api("POST", "/alerts/", reqBody, &session.Token)
+		a.Equal(400, res.Code)
+
+		handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").
+			Updates(map[string]any{"email": "alert@example.com", "email_verified": false})
+		res = api("POST", "/alerts/", reqBody, &session.Token)
+		a.Equal(400, res.Code)
+
+		handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("email_verified", true)
+	}
+
+	// POST /alerts/
+	{
+		reqBody := gin.H{
+			"securityUuid": securityUuid,
+			"marketCode":   "TEST",
+			"type":         "priceAbove",
+			"threshold":    "100.5",
+		}
+		body, res := jsonbody[gin.H](
+			api("POST", "/alerts/", reqBody, &session.Token))
+		a.Equal(201, res.Code)
+		a.Equal(securityUuid, body["securityUuid"])
+		a.Equal("TEST", body["marketCode"])
+		a.Equal("priceAbove", body["type"])
+		a.Equal("100.5", body["threshold"])
+		a.Nil(body["triggeredAt"])
+
+		alertId = strconv.Itoa(int(body["id"].(float64)))
+	}
+
+	// POST /alerts/ (dividend)
+	{
+		reqBody := gin.H{
+			"securityUuid": securityUuid,
+			"type":         "dividend",
+		}
+		body, res := jsonbody[gin.H](
+			api("POST", "/alerts/", reqBody, &session.Token))
+		a.Equal(201, res.Code)
+		a.Equal("dividend", body["type"])
+		a.Nil(body["marketCode"])
+	}
+
+	// GET /alerts/
+	{
+		body, res := jsonbody[[]gin.H](
+			api("GET", "/alerts/", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(body, 2)
+	}
+
+	// Invalid requests
+	{
+		res := api("GET", "/alerts/", nil, nil)
+		a.Equal(401, res.Code)
+
+		res = api("POST", "/alerts/", gin.H{
+			"securityUuid": securityUuid,
+			"type":         "unknown",
+		}, &session.Token)
+		a.Equal(400, res.Code)
+
+		res = api("POST", "/alerts/", gin.H{
+			"securityUuid": securityUuid,
+			"type":         "priceBelow",
+			"threshold":    "1",
+		}, &session.Token)
+		a.Equal(400, res.Code)
+
+		res = api("POST", "/alerts/", gin.H{
+			"securityUuid": securityUuid,
+			"marketCode":   "TEST",
+			"type":         "priceBelow",
+		}, &session.Token)
+		a.Equal(400, res.Code)
+
+		res = api("POST", "/alerts/", gin.H{
+			"securityUuid": securityUuid,
+			"marketCode":   "UNKNOWN",
+			"type":         "priceBelow",
+			"threshold":    "1",
+		}, &session.Token)
+		a.Equal(400, res.Code)
+
+		res = api("POST", "/alerts/", gin.H{
+			"securityUuid": "11111111-1111-1111-1111-111111111111",
+			"type":         "dividend",
+		}, &session.Token)
+		a.Equal(400, res.Code)
+
+		res = api("DELETE", "/alerts/invalid-id", nil, &session.Token)
+		a.Equal(404, res.Code)
+	}
+
+	// DELETE /alerts/:id
+	{
+		body, res := jsonbody[gin.H](
+			api("DELETE", "/alerts/"+alertId, nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal("priceAbove", body["type"])
+
+		res = api("DELETE", "/alerts/"+alertId, nil, &session.Token)
+		a.Equal(404, res.Code)
+	}
+
+	// Delete security (and its alerts)
+	{
+		res := api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+
+		body, res := jsonbody[[]gin.H](
+			api("GET", "/alerts/", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(body, 0)
+	}
+
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").
+		Updates(map[string]any{"email": nil, "email_verified": false})
+	handlerConfig.DB.Delete(&db.Market{Code: "TEST"})
+}
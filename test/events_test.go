@@ -0,0 +1,241 @@
+package test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityEvents(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Test events"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+	url := "/securities/uuid/" + securityUuid + "/events"
+
+	// Create
+	var eventId string
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", url, gin.H{"date": "2021-05-03", "type": "dividend", "amount": "1.25", "currencyCode": "EUR"}, &session.Token))
+		a.Equal(201, res.Code)
+		a.Equal("2021-05-03", body["date"])
+		a.Equal("1.2500", body["amount"])
+		eventId = strconv.Itoa(int(body["id"].(float64)))
+
+		res = api("POST", url, gin.H{"date": "2021-06-01", "type": "split", "ratio": "2:1"}, &session.Token)
+		a.Equal(201, res.Code)
+	}
+
+	// Validation
+	for _, input := range []gin.H{
+		{"date": "2021-05-03", "type": "merger"},
+		{"date": "2021-13-03", "type": "split", "ratio": "2:1"},
+		{"date": "2021-05-03", "type": "dividend", "amount": "1"},
+		{"date": "2021-05-03", "type": "dividend", "amount": "-1", "currencyCode": "EUR"},
+		{"date": "2021-05-03", "type": "dividend", "amount": "1.23456", "currencyCode": "EUR"},
+		{"date": "2021-05-03", "type": "dividend", "amount": "1", "currencyCode": "XYZ"},
+		{"date": "2021-05-03", "type": "dividend", "amount": "1", "currencyCode": "EUR", "ratio": "2:1"},
+		{"date": "2021-05-03", "type": "split", "ratio": "2"},
+		{"date": "2021-05-03", "type": "split", "ratio": "0:1"},
+		{"date": "2021-05-03", "type": "split", "ratio": "2:1", "amount": "1"},
+	} {
+		res := api("POST", url, input, &session.Token)
+		a.Equal(400, res.Code, input)
+	}
+
+	// Update
+	{
+		body, res := jsonbody[gin.H](
+			api("PUT", url+"/"+eventId, gin.H{"date": "2021-05-04", "type": "dividend", "amount": "1.3", "currencyCode": "USD"}, &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal("2021-05-04", body["date"])
+		a.Equal("USD", body["currencyCode"])
+
+		res = api("PUT", url+"/0", gin.H{"date": "2021-05-04", "type": "split", "ratio": "1:1"}, &session.Token)
+		a.Equal(404, res.Code)
+	}
+
+	// Bulk upsert by date and type
+	{
+		events, res := jsonbody[[]gin.H](
+			api("PATCH", url, []gin.H{
+				{"date": "2021-05-04", "type": "dividend", "amount": "1.4", "currencyCode": "USD"},
+				{"date": "2021-11-04", "type": "dividend", "amount": "1.5", "currencyCode": "USD"},
+			}, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(events, 2)
+		a.Equal(eventId, strconv.Itoa(int(events[0]["id"].(float64))))
+
+		res = api("PATCH", url, []gin.H{
+			{"date": "2022-05-04", "type": "dividend", "amount": "1", "currencyCode": "USD"},
+			{"date": "2022-05-04", "type": "dividend", "amount": "2", "currencyCode": "USD"},
+		}, &session.Token)
+		a.Equal(400, res.Code)
+
+		res = api("PATCH", url, []any{nil}, &session.Token)
+		a.Equal(400, res.Code)
+
+		events, res = jsonbody[[]gin.H](api("GET", url, nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(events, 3)
+		a.Equal("1.4000", events[0]["amount"])
+		a.Equal("split", events[1]["type"])
+		a.Equal("2021-11-04", events[2]["date"])
+	}
+
+	// Delete
+	{
+		res := api("DELETE", url+"/"+eventId, nil, &session.Token)
+		a.Equal(200, res.Code)
+
+		res = api("DELETE", url+"/"+eventId, nil, &session.Token)
+		a.Equal(404, res.Code)
+	}
+
+	res = api("GET", "/securities/uuid/00000000-0000-0000-0000-000000000000/events", nil, &session.Token)
+	a.Equal(404, res.Code)
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+}
+
+func TestEventCalendar(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	var securityUuids []string
+	for _, name := range []string{"Calendar A", "Calendar B"} {
+		body, res := jsonbody[gin.H](
+			api("POST", "/securities/", gin.H{"name": name, "securityType": "test-calendar", "isin": "XS0000000009"}, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuids = append(securityUuids, body["uuid"].(string))
+	}
+
+	res := api("PATCH", "/securities/uuid/"+securityUuids[0]+"/events", []gin.H{
+		{"date": "2031-03-01", "type": "dividend", "amount": "0.5", "currencyCode": "EUR"},
+		{"date": "2031-06-01", "type": "split", "ratio": "2:1"},
+	}, &session.Token)
+	a.Equal(200, res.Code)
+	res = api("PATCH", "/securities/uuid/"+securityUuids[1]+"/events", []gin.H{
+		{"date": "2031-02-01", "type": "dividend", "amount": "1", "currencyCode": "EUR"},
+		{"date": "2032-02-01", "type": "dividend", "amount": "1", "currencyCode": "EUR"},
+	}, &session.Token)
+	a.Equal(200, res.Code)
+
+	url := "/events/calendar?from=2031-01-01&to=2031-12-31&securityType=test-calendar"
+
+	// All events in range sorted by date
+	{
+		body, res := jsonbody[gin.H](api("GET", url, nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal(3., body["params"].(map[string]any)["totalCount"])
+		entries := body["entries"].([]any)
+		a.Len(entries, 3)
+		first := entries[0].(map[string]any)
+		a.Equal("2031-02-01", first["date"])
+		a.Equal("Calendar B", first["securityName"])
+		a.Equal("XS0000000009", first["isin"])
+		a.Nil(first["shares"])
+	}
+
+	// Filter by type, pagination
+	{
+		body, res := jsonbody[gin.H](api("GET", url+"&type=dividend&limit=1&skip=1", nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal(2., body["params"].(map[string]any)["totalCount"])
+		entries := body["entries"].([]any)
+		a.Len(entries, 1)
+		a.Equal("Calendar A", entries[0].(map[string]any)["securityName"])
+
+		res = api("GET", url+"&type=merger", nil, nil)
+		a.Equal(400, res.Code)
+	}
+
+	// Limit defaults to 100 and is capped, e.g. for GraphQL
+	{
+		query := &model.EventCalendarQuery{}
+		handlerConfig.EventService.GetEventCalendar(query, nil)
+		a.Equal(100, query.Limit)
+
+		query = &model.EventCalendarQuery{Limit: 100000, Skip: -1}
+		handlerConfig.EventService.GetEventCalendar(query, nil)
+		a.Equal(1000, query.Limit)
+		a.Equal(0, query.Skip)
+	}
+
+	// Portfolio with 10 shares of security A
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/portfolios/", gin.H{"name": "Calendar", "note": "", "baseCurrencyCode": "EUR"}, &session.Token))
+		a.Equal(201, res.Code)
+		portfolioId := strconv.Itoa(int(body["id"].(float64)))
+
+		depositAccountUuid, securitiesAccountUuid, portfolioSecurityUuid := uuid.New(), uuid.New(), uuid.New()
+		res = api("PUT", "/portfolios/"+portfolioId+"/accounts/"+depositAccountUuid.String(), gin.H{
+			"type":         "deposit",
+			"name":         "Deposit",
+			"currencyCode": "EUR",
+			"active":       true,
+			"updatedAt":    "2022-01-31T11:11:11Z",
+		}, &session.Token)
+		a.Equal(200, res.Code)
+		res = api("PUT", "/portfolios/"+portfolioId+"/accounts/"+securitiesAccountUuid.String(), gin.H{
+			"type":                 "securities",
+			"name":                 "Securities",
+			"referenceAccountUuid": depositAccountUuid,
+			"active":               true,
+			"updatedAt":            "2022-01-31T11:11:11Z",
+		}, &session.Token)
+		a.Equal(200, res.Code)
+		res = api("PUT", "/portfolios/"+portfolioId+"/securities/"+portfolioSecurityUuid.String(), gin.H{
+			"name":         "Calendar A",
+			"currencyCode": "EUR",
+			"securityUuid": securityUuids[0],
+			"active":       true,
+			"updatedAt":    "2022-01-31T11:11:11Z",
+			"events":       []any{},
+		}, &session.Token)
+		a.Equal(200, res.Code)
+		res = api("PUT", "/portfolios/"+portfolioId+"/transactions/"+uuid.New().String(), gin.H{
+			"accountUuid":           securitiesAccountUuid,
+			"type":                  "SecuritiesOrder",
+			"datetime":              "2022-01-31T11:11:11Z",
+			"shares":                "10",
+			"portfolioSecurityUuid": portfolioSecurityUuid,
+			"note":                  "",
+			"units":                 []gin.H{},
+		}, &session.Token)
+		a.Equal(200, res.Code)
+
+		body, res = jsonbody[gin.H](api("GET", url+"&type=dividend&portfolioId="+portfolioId, nil, &session.Token))
+		a.Equal(200, res.Code)
+		entries := body["entries"].([]any)
+		a.Len(entries, 1)
+		a.Equal("10", entries[0].(map[string]any)["shares"])
+		a.Equal("5", entries[0].(map[string]any)["expectedIncome"])
+
+		res = api("GET", url+"&portfolioId="+portfolioId, nil, nil)
+		a.Equal(401, res.Code)
+		res = api("GET", url+"&portfolioId=0"+portfolioId+"0", nil, &session.Token)
+		a.Equal(404, res.Code)
+
+		res = api("DELETE", "/portfolios/"+portfolioId, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	for _, securityUuid := range securityUuids {
+		res := api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+}
@@ -39,6 +39,8 @@ func TestAuthRequired(t *testing.T) {
 		{"GET", "/auth/sessions"},
 		{"GET", "/auth/users/me"},
 		{"POST", "/auth/users/me/password"},
+		{"POST", "/auth/users/me/email"},
+		{"POST", "/auth/users/me/email/verification"},
 		{"DELETE", "/auth/users/me"},
 		{"POST", "/portfolios/"},
 		{"GET", "/portfolios/"},
@@ -55,6 +57,7 @@ func TestAuthRequired(t *testing.T) {
 		{"GET", "/portfolios/42/securities/"},
 		{"PUT", "/portfolios/42/securities/42"},
 		{"DELETE", "/portfolios/42/securities/42"},
+		{"GET", "/portfolios/42/allocation"},
 		{"GET", "/securities/"},
 		{"POST", "/securities/"},
 		{"GET", "/securities/42"},
@@ -66,6 +69,26 @@ func TestAuthRequired(t *testing.T) {
 		{"PUT", "/tags/42"},
 		{"DELETE", "/tags/42"},
 		{"GET", "/securities/maintenance/gaps"},
+		{"GET", "/securities/maintenance/price-updates"},
+		{"POST", "/securities/maintenance/price-updates"},
+		{"POST", "/securities/prices/bulk"},
+		{"GET", "/securities/uuid/42/history"},
+		{"POST", "/securities/uuid/42/history/42/revert"},
+		{"POST", "/securities/uuid/42/merge"},
+		{"GET", "/securities/maintenance/price-findings"},
+		{"POST", "/securities/maintenance/price-findings"},
+		{"POST", "/securities/maintenance/price-findings/42/acknowledgement"},
+		{"DELETE", "/securities/maintenance/price-findings/42/acknowledgement"},
+		{"DELETE", "/securities/maintenance/cache"},
+		{"GET", "/securities/uuid/42/events"},
+		{"POST", "/securities/uuid/42/events"},
+		{"PATCH", "/securities/uuid/42/events"},
+		{"PUT", "/securities/uuid/42/events/42"},
+		{"DELETE", "/securities/uuid/42/events/42"},
+		{"PUT", "/securities/uuid/42/holdings"},
+		{"POST", "/markets/"},
+		{"PUT", "/markets/42"},
+		{"DELETE", "/markets/42"},
 	}
 
 	for _, tc := range testCases {
@@ -98,6 +121,26 @@ func TestAdminAuth(t *testing.T) {
 		{"PUT", "/tags/42"},
 		{"DELETE", "/tags/42"},
 		{"GET", "/securities/maintenance/gaps"},
+		{"GET", "/securities/maintenance/price-updates"},
+		{"POST", "/securities/maintenance/price-updates"},
+		{"POST", "/securities/prices/bulk"},
+		{"GET", "/securities/uuid/42/history"},
+		{"POST", "/securities/uuid/42/history/42/revert"},
+		{"POST", "/securities/uuid/42/merge"},
+		{"GET", "/securities/maintenance/price-findings"},
+		{"POST", "/securities/maintenance/price-findings"},
+		{"POST", "/securities/maintenance/price-findings/42/acknowledgement"},
+		{"DELETE", "/securities/maintenance/price-findings/42/acknowledgement"},
+		{"DELETE", "/securities/maintenance/cache"},
+		{"GET", "/securities/uuid/42/events"},
+		{"POST", "/securities/uuid/42/events"},
+		{"PATCH", "/securities/uuid/42/events"},
+		{"PUT", "/securities/uuid/42/events/42"},
+		{"DELETE", "/securities/uuid/42/events/42"},
+		{"PUT", "/securities/uuid/42/holdings"},
+		{"POST", "/markets/"},
+		{"PUT", "/markets/42"},
+		{"DELETE", "/markets/42"},
 	}
 
 	for _, tc := range testCases {
@@ -77,6 +77,17 @@ func api(method, target string, body any, token *string) *httptest.ResponseRecor
 	return res
 }
 
+func apiRaw(method, target, contentType string, body io.Reader, token *string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set("Content-Type", contentType)
+	if token != nil {
+		req.Header.Add("Authorization", "Bearer "+*token)
+	}
+	res := httptest.NewRecorder()
+	app.ServeHTTP(res, req)
+	return res
+}
+
 func jsonbody[T any](res *httptest.ResponseRecorder) (T, *httptest.ResponseRecorder) {
 	var body T
 	err := json.Unmarshal(res.Body.Bytes(), &body)
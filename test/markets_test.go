@@ -0,0 +1,236 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketCalendar(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	calendar := "de"
+	handlerConfig.DB.Create(&db.Market{Code: "TESTCAL", Name: "Test market", Calendar: &calendar})
+
+	a := assert.New(t)
+
+	// GET /markets/$code/calendar
+	{
+		body, res := jsonbody[gin.H](
+			api("GET", "/markets/TESTCAL/calendar?year=2022", nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal("TESTCAL", body["marketCode"])
+		a.Equal("de", body["calendar"])
+		a.Equal(2022., body["year"])
+		a.Equal(257., body["tradingDays"])
+		holidays := body["holidays"].([]any)
+		a.Len(holidays, 3)
+		a.Equal("2022-04-15", holidays[0].(map[string]any)["date"])
+		a.Equal("Good Friday", holidays[0].(map[string]any)["name"])
+
+		res = api("GET", "/markets/UNKNOWN/calendar", nil, nil)
+		a.Equal(404, res.Code)
+
+		res = api("GET", "/markets/TESTCAL/calendar?year=invalid", nil, nil)
+		a.Equal(400, res.Code)
+	}
+
+	// Prices are carried forward over holidays
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/securities/", gin.H{"name": "Test calendar"}, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuid := body["uuid"].(string)
+
+		res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTCAL",
+			gin.H{"currencyCode": "EUR", "prices": []gin.H{
+				{"date": "2022-04-14", "close": 1},
+				{"date": "2022-04-20", "close": 2},
+			}}, &session.Token)
+		a.Equal(200, res.Code)
+
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTCAL?from=2022-04-14&carryForward=true", nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal([]any{
+			map[string]any{"date": "2022-04-14", "close": 1.},
+			map[string]any{"date": "2022-04-19", "close": 1.},
+			map[string]any{"date": "2022-04-20", "close": 2.},
+		}, body["prices"])
+
+		// Gaps are measured in trading days
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/maintenance/gaps?minDuration=1&marketCode=TESTCAL&totalCount=true", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal(1., body["params"].(map[string]any)["totalCount"])
+		gaps := body["entries"].([]any)
+		a.Len(gaps, 1)
+		g := gaps[0].(map[string]any)
+		a.Equal(securityUuid, g["securityUuid"])
+		a.Equal("2022-04-19", g["fromDate"])
+		a.Equal("2022-04-19", g["toDate"])
+		a.Equal(1., g["duration"])
+
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/maintenance/gaps?minDuration=2&marketCode=TESTCAL&totalCount=true", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal(0., body["params"].(map[string]any)["totalCount"])
+
+		// Gaps are paginated by offset and cursor
+		res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTCAL",
+			gin.H{"prices": []gin.H{
+				{"date": "2022-04-22", "close": 3},
+				{"date": "2022-04-28", "close": 4},
+			}}, &session.Token)
+		a.Equal(200, res.Code)
+
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/maintenance/gaps?minDuration=1&marketCode=TESTCAL&limit=1&totalCount=true", nil, &session.Token))
+		a.Equal(200, res.Code)
+		params := body["params"].(map[string]any)
+		a.Equal(3., params["totalCount"])
+		a.Equal("2022-04-19", body["entries"].([]any)[0].(map[string]any)["fromDate"])
+		cursor := params["nextCursor"].(string)
+
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/maintenance/gaps?minDuration=1&marketCode=TESTCAL&limit=1&cursor="+cursor, nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal("2022-04-21", body["entries"].([]any)[0].(map[string]any)["fromDate"])
+		// Total count is only determined on request
+		a.Nil(body["params"].(map[string]any)["totalCount"])
+
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/maintenance/gaps?minDuration=1&marketCode=TESTCAL&limit=1&skip=2", nil, &session.Token))
+		a.Equal(200, res.Code)
+		entry := body["entries"].([]any)[0].(map[string]any)
+		a.Equal("2022-04-25", entry["fromDate"])
+		a.Equal("2022-04-27", entry["toDate"])
+		a.Equal(3., entry["duration"])
+		a.Nil(body["params"].(map[string]any)["nextCursor"])
+
+		res = api("GET", "/securities/maintenance/gaps?cursor=invalid", nil, &session.Token)
+		a.Equal(400, res.Code)
+		res = api("GET", "/securities/maintenance/gaps?limit=1001", nil, &session.Token)
+		a.Equal(400, res.Code)
+		res = api("GET", "/securities/maintenance/gaps?maxResults=1001", nil, &session.Token)
+		a.Equal(400, res.Code)
+
+		res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTCAL"})
+}
+
+func TestMarkets(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	// POST /markets/
+	{
+		reqBody := gin.H{
+			"code":         "TESTMKT",
+			"name":         "Test market",
+			"mic":          "XTST",
+			"countryCode":  "DE",
+			"timezone":     "Europe/Berlin",
+			"currencyCode": "EUR",
+			"openTime":     "09:00",
+			"closeTime":    "17:30",
+			"calendar":     "de",
+		}
+		body, res := jsonbody[gin.H](
+			api("POST", "/markets/", reqBody, &session.Token))
+		a.Equal(201, res.Code)
+		a.Equal("TESTMKT", body["code"])
+		a.Equal("XTST", body["mic"])
+		a.Equal("Europe/Berlin", body["timezone"])
+		a.Equal("17:30", body["closeTime"])
+
+		res = api("POST", "/markets/", reqBody, &session.Token)
+		a.Equal(400, res.Code)
+
+		for _, invalid := range []gin.H{
+			{"code": "TESTINV", "name": "Invalid", "timezone": "Mars/Olympus"},
+			{"code": "TESTINV", "name": "Invalid", "calendar": "unknown"},
+			{"code": "TESTINV", "name": "Invalid", "openTime": "25:00"},
+			{"code": "TESTINV", "name": "Invalid", "countryCode": "XX"},
+			{"code": "TESTINV", "name": "Invalid", "currencyCode": "XXX"},
+		} {
+			res = api("POST", "/markets/", invalid, &session.Token)
+			a.Equal(400, res.Code, invalid)
+		}
+	}
+
+	// GET /markets/
+	{
+		body, res := jsonbody[[]gin.H](
+			api("GET", "/markets/", nil, nil))
+		a.Equal(200, res.Code)
+		found := false
+		for _, m := range body {
+			if m["code"] == "TESTMKT" {
+				found = true
+				a.Equal("Test market", m["name"])
+			}
+		}
+		a.True(found)
+	}
+
+	// PATCH /securities/uuid/$uuid/markets/$code -> unknown market
+	var securityUuid string
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/securities/", gin.H{"name": "Test markets"}, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuid = body["uuid"].(string)
+
+		body, res = jsonbody[gin.H](
+			api("PATCH", "/securities/uuid/"+securityUuid+"/markets/UNKNOWN", gin.H{"currencyCode": "EUR"}, &session.Token))
+		a.Equal(400, res.Code)
+		a.Equal("unknown market UNKNOWN", body["message"])
+
+		res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTMKT", gin.H{"currencyCode": "EUR"}, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	// PUT /markets/$code -> rename
+	{
+		reqBody := gin.H{"code": "TESTMKT2", "name": "Renamed market"}
+		body, res := jsonbody[gin.H](
+			api("PUT", "/markets/TESTMKT", reqBody, &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal("TESTMKT2", body["code"])
+		a.Nil(body["mic"])
+
+		res = api("GET", "/markets/TESTMKT", nil, nil)
+		a.Equal(404, res.Code)
+
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/uuid/"+securityUuid, nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal("TESTMKT2", body["markets"].([]any)[0].(map[string]any)["marketCode"])
+
+		res = api("PUT", "/markets/UNKNOWN", reqBody, &session.Token)
+		a.Equal(404, res.Code)
+	}
+
+	// DELETE /markets/$code
+	{
+		res := api("DELETE", "/markets/TESTMKT2", nil, &session.Token)
+		a.Equal(400, res.Code, "Market in use")
+
+		res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+
+		body, res := jsonbody[gin.H](
+			api("DELETE", "/markets/TESTMKT2", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal("Renamed market", body["name"])
+
+		res = api("DELETE", "/markets/TESTMKT2", nil, &session.Token)
+		a.Equal(404, res.Code)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -403,3 +404,283 @@ func TestPortfolios(t *testing.T) {
 		a.Equal(portfolioIdInt, uint(body["id"].(float64)))
 	}
 }
+
+func TestClonePortfolio(t *testing.T) {
+	a := assert.New(t)
+
+	var portfolioId string
+	depositAccountUuid := uuid.New()
+	securitiesAccountUuid := uuid.New()
+	securityUuid := uuid.New()
+	depositTransactionUuid := uuid.New()
+	securitiesTransactionUuid := uuid.New()
+
+	// Prepare source portfolio
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/portfolios/", gin.H{"name": "Source", "note": "", "baseCurrencyCode": "EUR"}, &session.Token))
+		a.Equal(201, res.Code)
+		portfolioId = strconv.Itoa(int(body["id"].(float64)))
+
+		res = api("PUT", "/portfolios/"+portfolioId+"/accounts/"+depositAccountUuid.String(), gin.H{
+			"type":         "deposit",
+			"name":         "Deposit",
+			"currencyCode": "EUR",
+			"active":       true,
+			"updatedAt":    "2022-01-31T11:11:11Z",
+		}, &session.Token)
+		a.Equal(200, res.Code)
+
+		res = api("PUT", "/portfolios/"+portfolioId+"/accounts/"+securitiesAccountUuid.String(), gin.H{
+			"type":                 "securities",
+			"name":                 "Securities",
+			"referenceAccountUuid": depositAccountUuid,
+			"active":               true,
+			"updatedAt":            "2022-01-31T11:11:11Z",
+		}, &session.Token)
+		a.Equal(200, res.Code)
+
+		res = api("PUT", "/portfolios/"+portfolioId+"/securities/"+securityUuid.String(), gin.H{
+			"name":         "Security",
+			"currencyCode": "EUR",
+			"active":       true,
+			"updatedAt":    "2022-01-31T11:11:11Z",
+			"events":       []any{},
+		}, &session.Token)
+		a.Equal(200, res.Code)
+
+		res = api("PUT", "/portfolios/"+portfolioId+"/transactions/"+depositTransactionUuid.String(), gin.H{
+			"accountUuid": depositAccountUuid,
+			"type":        "Payment",
+			"datetime":    "2022-01-31T11:11:11Z",
+			"note":        "",
+			"units": []gin.H{
+				{"type": "base", "amount": "100", "currencyCode": "EUR"},
+			},
+		}, &session.Token)
+		a.Equal(200, res.Code)
+
+		res = api("PUT", "/portfolios/"+portfolioId+"/transactions/"+securitiesTransactionUuid.String(), gin.H{
+			"accountUuid":            securitiesAccountUuid,
+			"type":                   "SecuritiesOrder",
+			"datetime":               "2022-01-31T11:11:11Z",
+			"partnerTransactionUuid": depositTransactionUuid,
+			"shares":                 "1",
+			"portfolioSecurityUuid":  securityUuid,
+			"note":                   "",
+			"units":                  []gin.H{},
+		}, &session.Token)
+		a.Equal(200, res.Code)
+
+		res = api("PUT", "/portfolios/"+portfolioId+"/transactions/"+depositTransactionUuid.String(), gin.H{
+			"accountUuid":            depositAccountUuid,
+			"type":                   "Payment",
+			"datetime":               "2022-01-31T11:11:11Z",
+			"partnerTransactionUuid": securitiesTransactionUuid,
+			"note":                   "",
+			"units": []gin.H{
+				{"type": "base", "amount": "100", "currencyCode": "EUR"},
+			},
+		}, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	// POST /portfolios/$id/clone
+	var cloneId string
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/portfolios/"+portfolioId+"/clone", nil, &session.Token))
+		a.Equal(201, res.Code)
+		a.Equal("Source (copy)", body["name"])
+		a.Equal("EUR", body["baseCurrencyCode"])
+		cloneId = strconv.Itoa(int(body["id"].(float64)))
+		a.NotEqual(portfolioId, cloneId)
+	}
+
+	// Structure of clone is equal, UUIDs are remapped
+	{
+		accounts, res := jsonbody[[]gin.H](
+			api("GET", "/portfolios/"+cloneId+"/accounts/", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(accounts, 2)
+		accountUuids := map[string]string{}
+		for _, acc := range accounts {
+			accountUuids[acc["name"].(string)] = acc["uuid"].(string)
+		}
+		a.NotEqual(depositAccountUuid.String(), accountUuids["Deposit"])
+		for _, acc := range accounts {
+			if acc["name"] == "Securities" {
+				a.Equal(accountUuids["Deposit"], acc["referenceAccountUuid"])
+			}
+		}
+
+		securities, res := jsonbody[[]gin.H](
+			api("GET", "/portfolios/"+cloneId+"/securities/", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(securities, 1)
+		a.NotEqual(securityUuid.String(), securities[0]["uuid"])
+
+		transactions, res := jsonbody[[]gin.H](
+			api("GET", "/portfolios/"+cloneId+"/transactions/", nil, &session.Token))
+		a.Equal(200, res.Code)
+		a.Len(transactions, 2)
+		byType := map[string]gin.H{}
+		for _, tx := range transactions {
+			byType[tx["type"].(string)] = tx
+		}
+		payment, order := byType["Payment"], byType["SecuritiesOrder"]
+		a.Equal(accountUuids["Deposit"], payment["accountUuid"])
+		a.Equal(accountUuids["Securities"], order["accountUuid"])
+		a.Equal(securities[0]["uuid"], order["portfolioSecurityUuid"])
+		a.Equal(order["uuid"], payment["partnerTransactionUuid"])
+		a.Equal(payment["uuid"], order["partnerTransactionUuid"])
+		a.Len(payment["units"], 1)
+	}
+
+	// POST /portfolios/$id/clone -> template without transactions
+	var templateId string
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/portfolios/"+portfolioId+"/clone", gin.H{"name": "Template", "skipTransactions": true}, &session.Token))
+		a.Equal(201, res.Code)
+		a.Equal("Template", body["name"])
+		templateId = strconv.Itoa(int(body["id"].(float64)))
+
+		accounts, _ := jsonbody[[]gin.H](
+			api("GET", "/portfolios/"+templateId+"/accounts/", nil, &session.Token))
+		a.Len(accounts, 2)
+		securities, _ := jsonbody[[]gin.H](
+			api("GET", "/portfolios/"+templateId+"/securities/", nil, &session.Token))
+		a.Len(securities, 1)
+		transactions, _ := jsonbody[[]gin.H](
+			api("GET", "/portfolios/"+templateId+"/transactions/", nil, &session.Token))
+		a.Len(transactions, 0)
+	}
+
+	// Clean up
+	for _, id := range []string{portfolioId, cloneId, templateId} {
+		res := api("DELETE", "/portfolios/"+id, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+}
+
+func TestPortfolioAllocation(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTALC", Name: "Test market"})
+
+	a := assert.New(t)
+
+	// Taxonomy with categories of fund and of constituent
+	body, res := jsonbody[gin.H](api("POST", "/taxonomies/", gin.H{"name": "Allocation root"}, &session.Token))
+	a.Equal(201, res.Code)
+	rootUuid := body["uuid"].(string)
+	categories := map[string]string{}
+	for _, name := range []string{"Funds", "Tech"} {
+		body, res := jsonbody[gin.H](
+			api("POST", "/taxonomies/", gin.H{"name": name, "parentUuid": rootUuid}, &session.Token))
+		a.Equal(201, res.Code)
+		categories[name] = body["uuid"].(string)
+	}
+
+	securityUuids := map[string]string{}
+	for _, name := range []string{"Allocation Fund", "Allocation Tech", "Allocation Other"} {
+		body, res := jsonbody[gin.H](api("POST", "/securities/", gin.H{"name": name}, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuids[name] = body["uuid"].(string)
+	}
+	res = api("PATCH", "/securities/uuid/"+securityUuids["Allocation Fund"]+"/markets/TESTALC",
+		gin.H{"currencyCode": "EUR", "prices": []gin.H{{"date": "2022-01-31", "close": 100}}}, &session.Token)
+	a.Equal(200, res.Code)
+	res = api("PUT", "/securities/uuid/"+securityUuids["Allocation Fund"]+"/taxonomies/"+rootUuid,
+		[]gin.H{{"taxonomyUuid": categories["Funds"], "weight": "100"}}, &session.Token)
+	a.Equal(200, res.Code)
+	res = api("PUT", "/securities/uuid/"+securityUuids["Allocation Tech"]+"/taxonomies/"+rootUuid,
+		[]gin.H{{"taxonomyUuid": categories["Tech"], "weight": "100"}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	// Cash is not in master data and remains with fund
+	res = api("PUT", "/securities/uuid/"+securityUuids["Allocation Fund"]+"/holdings", gin.H{
+		"asOf": "2022-01-31",
+		"holdings": []gin.H{
+			{"securityUuid": securityUuids["Allocation Tech"], "weight": 60},
+			{"securityUuid": securityUuids["Allocation Other"], "weight": 30},
+			{"name": "Cash", "weight": 10},
+		},
+	}, &session.Token)
+	a.Equal(200, res.Code)
+
+	// Portfolio holds 10 shares of fund, security without master data is not valued
+	body, res = jsonbody[gin.H](api("POST", "/portfolios/",
+		gin.H{"name": "Allocation", "note": "", "baseCurrencyCode": "EUR"}, &session.Token))
+	a.Equal(201, res.Code)
+	portfolioId := strconv.Itoa(int(body["id"].(float64)))
+
+	depositAccountUuid := uuid.New()
+	securitiesAccountUuid := uuid.New()
+	res = api("PUT", "/portfolios/"+portfolioId+"/accounts/"+depositAccountUuid.String(), gin.H{
+		"type": "deposit", "name": "Deposit", "currencyCode": "EUR", "active": true,
+	}, &session.Token)
+	a.Equal(200, res.Code)
+	res = api("PUT", "/portfolios/"+portfolioId+"/accounts/"+securitiesAccountUuid.String(), gin.H{
+		"type": "securities", "name": "Securities", "referenceAccountUuid": depositAccountUuid, "active": true,
+	}, &session.Token)
+	a.Equal(200, res.Code)
+
+	fundUuid := uuid.MustParse(securityUuids["Allocation Fund"])
+	unknownUuid := uuid.New()
+	for portfolioSecurityUuid, securityUuid := range map[uuid.UUID]*uuid.UUID{uuid.New(): &fundUuid, unknownUuid: nil} {
+		res = api("PUT", "/portfolios/"+portfolioId+"/securities/"+portfolioSecurityUuid.String(), gin.H{
+			"name": "Security", "currencyCode": "EUR", "active": true, "events": []any{},
+			"securityUuid": securityUuid,
+		}, &session.Token)
+		a.Equal(200, res.Code)
+
+		res = api("PUT", "/portfolios/"+portfolioId+"/transactions/"+uuid.New().String(), gin.H{
+			"accountUuid":           securitiesAccountUuid,
+			"type":                  "SecuritiesOrder",
+			"datetime":              "2022-01-31T11:11:11Z",
+			"shares":                "10",
+			"portfolioSecurityUuid": portfolioSecurityUuid,
+			"note":                  "",
+			"units":                 []gin.H{},
+		}, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	entry := func(category string, value, weight string) map[string]any {
+		if category == "" {
+			return map[string]any{"taxonomyUuid": nil, "name": nil, "value": value, "weight": weight}
+		}
+		return map[string]any{"taxonomyUuid": categories[category], "name": category, "value": value, "weight": weight}
+	}
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/portfolios/"+portfolioId+"/allocation?rootTaxonomyUuid="+rootUuid, nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("EUR", body["currencyCode"])
+	a.Equal("1000", body["totalValue"])
+	a.Equal([]any{entry("Funds", "1000", "100")}, body["entries"])
+	a.Equal([]any{unknownUuid.String()}, body["unvaluedSecurities"])
+
+	// Look-through allocates fund by constituents
+	body, res = jsonbody[gin.H](
+		api("GET", "/portfolios/"+portfolioId+"/allocation?lookThrough=true&rootTaxonomyUuid="+rootUuid, nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("1000", body["totalValue"])
+	a.Equal([]any{entry("Tech", "600", "60"), entry("", "300", "30"), entry("Funds", "100", "10")}, body["entries"])
+
+	res = api("GET", "/portfolios/"+portfolioId+"/allocation?rootTaxonomyUuid="+categories["Tech"], nil, &session.Token)
+	a.Equal(400, res.Code)
+	res = api("GET", "/portfolios/"+portfolioId+"/allocation", nil, &session.Token)
+	a.Equal(400, res.Code)
+
+	res = api("DELETE", "/portfolios/"+portfolioId, nil, &session.Token)
+	a.Equal(200, res.Code)
+	for _, securityUuid := range securityUuids {
+		res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+	res = api("DELETE", "/taxonomies/"+rootUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTALC"})
+}
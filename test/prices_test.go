@@ -0,0 +1,538 @@
+package test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/portfolio-report/pr-api/db"
+	"github.com/portfolio-report/pr-api/graph/model"
+	"github.com/portfolio-report/pr-api/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceUpdates(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTPRC", Name: "Test market"})
+
+	a := assert.New(t)
+
+	dir := t.TempDir()
+	require.Nil(t, os.Mkdir(filepath.Join(dir, "TESTPRC"), 0o755))
+	priceFile := filepath.Join(dir, "TESTPRC", "TST.csv")
+	require.Nil(t, os.WriteFile(priceFile,
+		[]byte("date,close\n2022-01-03,1.5\n2022-01-04,1.6\n2022-01-05,1.7\n"), 0o644))
+	handlerConfig.PriceService.RegisterPriceProvider("TESTPRC", service.NewFilePriceProvider(dir))
+
+	var securityUuid string
+	{
+		body, res := jsonbody[gin.H](
+			api("POST", "/securities/", gin.H{"name": "Test prices"}, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuid = body["uuid"].(string)
+
+		res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTPRC",
+			gin.H{"currencyCode": "EUR", "symbol": "TST", "prices": []gin.H{
+				{"date": "2022-01-03", "close": 1},
+			}}, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	// updatePrices starts price update and returns status of security market once it is updated
+	updatePrices := func() gin.H {
+		started := time.Now()
+		for i := 0; ; i++ {
+			body, res := jsonbody[gin.H](
+				api("POST", "/securities/maintenance/price-updates", nil, &session.Token))
+			if res.Code == 202 {
+				a.Equal("started", body["status"])
+				break
+			}
+			// Update of previous test may still be running
+			require.Equal(t, 409, res.Code)
+			require.Less(t, i, 100)
+			time.Sleep(50 * time.Millisecond)
+		}
+
+		for i := 0; i < 100; i++ {
+			statuses, res := jsonbody[[]gin.H](
+				api("GET", "/securities/maintenance/price-updates", nil, &session.Token))
+			a.Equal(200, res.Code)
+			for _, s := range statuses {
+				if s["securityUuid"] != securityUuid || s["priceUpdateAt"] == nil {
+					continue
+				}
+				if updatedAt, _ := time.Parse(time.RFC3339Nano, s["priceUpdateAt"].(string)); !updatedAt.Before(started) {
+					return s
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		t.Fatal("price update did not finish")
+		return nil
+	}
+
+	// POST /securities/maintenance/price-updates -> prices after last price date are added in background
+	{
+		status := updatePrices()
+		a.Nil(status["priceUpdateError"])
+		a.Equal(0., status["priceUpdateFailures"])
+
+		body, res := jsonbody[gin.H](
+			api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTPRC?from=2022-01-01", nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal("2022-01-05", body["lastPriceDate"])
+		a.Equal([]any{
+			map[string]any{"date": "2022-01-03", "close": 1.},
+			map[string]any{"date": "2022-01-04", "close": 1.6},
+			map[string]any{"date": "2022-01-05", "close": 1.7},
+		}, body["prices"])
+	}
+
+	// Failures are recorded
+	{
+		require.Nil(t, os.Remove(priceFile))
+
+		status := updatePrices()
+		a.Contains(status["priceUpdateError"], "no price file found")
+		a.Equal(1., status["priceUpdateFailures"])
+
+		statuses, res := jsonbody[[]gin.H](
+			api("GET", "/securities/maintenance/price-updates?failed=true", nil, &session.Token))
+		a.Equal(200, res.Code)
+		found := false
+		for _, s := range statuses {
+			if s["securityUuid"] == securityUuid {
+				found = true
+			}
+		}
+		a.True(found)
+	}
+
+	// Invalid prices of provider fail update
+	{
+		require.Nil(t, os.WriteFile(priceFile, []byte("date,close\n2022-01-06,1.23456\n"), 0o644))
+
+		status := updatePrices()
+		a.Contains(status["priceUpdateError"], "more than 4 decimal places")
+		a.Equal(2., status["priceUpdateFailures"])
+	}
+
+	res := api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTPRC"})
+}
+
+func TestOhlcvPrices(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTOHLC", Name: "Test market"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Test OHLCV"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTOHLC",
+		gin.H{"currencyCode": "EUR", "prices": []gin.H{
+			{"date": "2021-01-04", "close": 10.5, "open": 10, "high": 11, "low": 9.5, "volume": 1200},
+			{"date": "2021-01-05", "close": 10.7},
+		}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	// Invalid prices are rejected
+	for _, invalid := range []gin.H{
+		{"date": "2021-01-06", "close": 1, "volume": -1},
+		{"date": "2021-01-06", "close": -1},
+		{"date": "2021-01-06", "close": 1000000},
+		{"date": "2021-01-06", "close": 1.23456},
+		{"date": "2021-01-06", "close": 1, "high": 1, "low": 1.1},
+	} {
+		res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTOHLC",
+			gin.H{"prices": []gin.H{invalid}}, &session.Token)
+		a.Equal(400, res.Code, invalid)
+	}
+
+	// Only close by default
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTOHLC?from=2021-01-01", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal([]any{
+		map[string]any{"date": "2021-01-04", "close": 10.5},
+		map[string]any{"date": "2021-01-05", "close": 10.7},
+	}, body["prices"])
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTOHLC?from=2021-01-01&fields=open,high,low,close,volume", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal([]any{
+		map[string]any{"date": "2021-01-04", "open": 10., "high": 11., "low": 9.5, "close": 10.5, "volume": 1200.},
+		map[string]any{"date": "2021-01-05", "open": nil, "high": nil, "low": nil, "close": 10.7, "volume": nil},
+	}, body["prices"])
+
+	// Updating close keeps existing open/high/low/volume
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTOHLC",
+		gin.H{"prices": []gin.H{{"date": "2021-01-04", "close": 10.6}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTOHLC?from=2021-01-04&fields=close,volume", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal(map[string]any{"date": "2021-01-04", "close": 10.6, "volume": 1200.},
+		body["prices"].([]any)[0])
+
+	res = api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTOHLC?fields=close,foo", nil, nil)
+	a.Equal(400, res.Code)
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTOHLC"})
+}
+
+func TestAdjustedPrices(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTADJ", Name: "Test market"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Test adjusted"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTADJ",
+		gin.H{"currencyCode": "EUR", "prices": []gin.H{
+			{"date": "2021-03-01", "close": 100, "volume": 10},
+			{"date": "2021-03-02", "close": 100},
+			{"date": "2021-03-03", "close": 50},
+			{"date": "2021-03-04", "close": 49},
+		}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	ratio, amount, currencyCode := "2:1", "1", "EUR"
+	handlerConfig.DB.Create(&[]db.Event{
+		{Date: model.Date(time.Date(2021, 3, 3, 0, 0, 0, 0, time.UTC)), Type: "split", Ratio: &ratio, SecurityUuid: securityUuid},
+		{Date: model.Date(time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)), Type: "dividend", Amount: &amount, CurrencyCode: &currencyCode, SecurityUuid: securityUuid},
+	})
+	handlerConfig.PriceService.InvalidatePriceAdjustments(securityUuid)
+
+	closes := func(query string) []any {
+		body, res := jsonbody[gin.H](
+			api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTADJ?from=2021-03-01"+query, nil, nil))
+		a.Equal(200, res.Code)
+		ret := []any{}
+		for _, p := range body["prices"].([]any) {
+			ret = append(ret, p.(map[string]any)["close"])
+		}
+		return ret
+	}
+
+	a.Equal([]any{100., 100., 50., 49.}, closes(""))
+	a.Equal([]any{50., 50., 50., 49.}, closes("&adjusted=splits"))
+	a.Equal([]any{49., 49., 49., 49.}, closes("&adjusted=total"))
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTADJ?from=2021-03-01&adjusted=splits&fields=volume", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal(20., body["prices"].([]any)[0].(map[string]any)["volume"])
+
+	res = api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTADJ?adjusted=foo", nil, nil)
+	a.Equal(400, res.Code)
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTADJ"})
+}
+
+func TestPricesBulk(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTBLK1", Name: "Test market 1"})
+	handlerConfig.DB.Create(&db.Market{Code: "TESTBLK2", Name: "Test market 2"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Test bulk"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	for _, marketCode := range []string{"TESTBLK1", "TESTBLK2"} {
+		res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/"+marketCode,
+			gin.H{"currencyCode": "EUR"}, &session.Token)
+		a.Equal(200, res.Code)
+	}
+
+	// CSV
+	{
+		csv := "securityUuid,marketCode,date,close,volume\n" +
+			securityUuid + ",TESTBLK1,2022-02-01,12.3456,100\n" +
+			securityUuid + ",TESTBLK1,2022-02-02,12.5,\n" +
+			securityUuid + ",TESTBLK2,2022-02-02,0.0001,\n" +
+			securityUuid + ",TESTBLK2,2022-02-03,-1,\n" +
+			securityUuid + ",XXXX,2022-02-03,1,\n" +
+			securityUuid + ",TESTBLK1,2022-02-02,12.6,\n" +
+			securityUuid + ",TESTBLK2,2022-02-04,1.23456,\n"
+		body, res := jsonbody[gin.H](
+			apiRaw("POST", "/securities/prices/bulk", "text/csv", strings.NewReader(csv), &session.Token))
+		a.Equal(200, res.Code)
+		// Later row of same market and date replaces earlier one
+		a.Equal(3., body["imported"])
+		a.Equal(2., body["markets"])
+		a.Equal(3., body["errorCount"])
+		errors := body["errors"].([]any)
+		a.Equal(5., errors[0].(map[string]any)["line"])
+		a.Equal(6., errors[1].(map[string]any)["line"])
+		a.Equal(8., errors[2].(map[string]any)["line"])
+		a.Contains(errors[2].(map[string]any)["error"], "more than 4 decimal places")
+	}
+
+	// NDJSON, decimals are parsed exactly
+	{
+		ndjson := `{"securityUuid":"` + securityUuid + `","marketCode":"TESTBLK1","date":"2022-02-03","close":"13.0001","open":12.9999}` + "\n" +
+			`{"securityUuid":"` + securityUuid + `","marketCode":"TESTBLK1","date":"2022-02-01","close":12.3457}` + "\n" +
+			`not json` + "\n"
+		body, res := jsonbody[gin.H](
+			apiRaw("POST", "/securities/prices/bulk", "application/x-ndjson", strings.NewReader(ndjson), &session.Token))
+		a.Equal(200, res.Code)
+		a.Equal(2., body["imported"])
+		a.Equal(1., body["errorCount"])
+	}
+
+	{
+		body, res := jsonbody[gin.H](
+			api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTBLK1?from=2022-01-01&fields=open,close,volume", nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal("2022-02-01", body["firstPriceDate"])
+		a.Equal("2022-02-03", body["lastPriceDate"])
+		a.Equal([]any{
+			map[string]any{"date": "2022-02-01", "open": nil, "close": 12.3457, "volume": 100.},
+			map[string]any{"date": "2022-02-02", "open": nil, "close": 12.6, "volume": nil},
+			map[string]any{"date": "2022-02-03", "open": 12.9999, "close": 13.0001, "volume": nil},
+		}, body["prices"])
+	}
+
+	// Prices read before stream failed are kept and reported
+	{
+		ndjson := `{"securityUuid":"` + securityUuid + `","marketCode":"TESTBLK1","date":"2022-02-04","close":14}` + "\n" +
+			strings.Repeat(" ", 2*1024*1024) + "\n"
+		body, res := jsonbody[gin.H](
+			apiRaw("POST", "/securities/prices/bulk", "application/x-ndjson", strings.NewReader(ndjson), &session.Token))
+		a.Equal(400, res.Code)
+		a.Equal(1., body["result"].(map[string]any)["imported"])
+
+		body, res = jsonbody[gin.H](
+			api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTBLK1?from=2022-01-01", nil, nil))
+		a.Equal(200, res.Code)
+		a.Equal("2022-02-04", body["lastPriceDate"])
+	}
+
+	res = apiRaw("POST", "/securities/prices/bulk", "application/json", strings.NewReader("[]"), &session.Token)
+	a.Equal(400, res.Code)
+	res = apiRaw("POST", "/securities/prices/bulk", "text/csv", strings.NewReader("date,close\n"), &session.Token)
+	a.Equal(400, res.Code)
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTBLK1"})
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTBLK2"})
+}
+
+func TestPricesBatch(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTBAT", Name: "Test market"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Test batch"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTBAT", gin.H{
+		"currencyCode": "EUR",
+		"prices": []gin.H{
+			{"date": "2022-02-01", "close": "1"},
+			{"date": "2022-02-02", "close": "2"},
+			{"date": "2022-02-03", "close": "3"},
+		}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	// Response of single series is cached
+	res = api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTBAT?from=2022-02-02", nil, nil)
+	a.Equal(200, res.Code)
+	single := res.Body.String()
+
+	// Changes outside of API are not visible in cached series
+	handlerConfig.DB.Model(&db.SecurityMarketPrice{}).
+		Where("date = '2022-02-03' AND security_market_id = (SELECT id FROM securities_markets WHERE market_code = 'TESTBAT')").
+		Update("close", "4")
+
+	series, res := jsonbody[[]gin.H](api("POST", "/securities/prices/batch", gin.H{"series": []gin.H{
+		{"uuid": securityUuid, "marketCode": "TESTBAT", "from": "2022-02-02"},
+		{"uuid": securityUuid, "marketCode": "TESTBAT", "from": "2022-01-01"},
+		{"uuid": securityUuid, "marketCode": "XXXX"},
+		{"uuid": uuid.NewString(), "marketCode": "TESTBAT"},
+	}}, nil))
+	a.Equal(200, res.Code)
+	a.Equal("application/json; charset=utf-8", res.Header().Get("Content-Type"))
+	a.Len(series, 4)
+
+	a.Equal(securityUuid, series[0]["uuid"])
+	a.Equal("TESTBAT", series[0]["marketCode"])
+	a.Equal("2022-02-02", series[0]["from"])
+	a.Equal(200., series[0]["status"])
+	singleBody, _ := json.Marshal(series[0]["series"])
+	a.JSONEq(single, string(singleBody))
+
+	a.Equal(200., series[1]["status"])
+	a.Equal(map[string]any{
+		"marketCode":     "TESTBAT",
+		"currencyCode":   "EUR",
+		"symbol":         nil,
+		"firstPriceDate": "2022-02-01",
+		"lastPriceDate":  "2022-02-03",
+		"prices": []any{
+			map[string]any{"date": "2022-02-01", "close": 1.},
+			map[string]any{"date": "2022-02-02", "close": 2.},
+			map[string]any{"date": "2022-02-03", "close": 4.},
+		},
+	}, series[1]["series"])
+
+	a.Equal(404., series[2]["status"])
+	a.Nil(series[2]["series"])
+	a.Equal(404., series[3]["status"])
+
+	// Series cached by batch are used by single requests
+	res = api("GET", "/securities/uuid/"+securityUuid+"/markets/TESTBAT?from=2022-01-01", nil, nil)
+	a.Equal(200, res.Code)
+	cached, _ := json.Marshal(series[1]["series"])
+	a.JSONEq(string(cached), res.Body.String())
+
+	// Validation
+	res = api("POST", "/securities/prices/batch", gin.H{}, nil)
+	a.Equal(400, res.Code)
+	res = api("POST", "/securities/prices/batch", gin.H{"series": []gin.H{{"uuid": "invalid", "marketCode": "TESTBAT"}}}, nil)
+	a.Equal(400, res.Code)
+	res = api("POST", "/securities/prices/batch", gin.H{"series": []gin.H{{"uuid": securityUuid}}}, nil)
+	a.Equal(400, res.Code)
+	res = api("POST", "/securities/prices/batch", gin.H{"series": []gin.H{{"uuid": securityUuid, "marketCode": "TESTBAT", "from": "2022-13-01"}}}, nil)
+	a.Equal(400, res.Code)
+	res = api("POST", "/securities/prices/batch", gin.H{"series": []gin.H{{"uuid": securityUuid, "marketCode": "TESTBAT", "from": "2023-02-31"}}}, nil)
+	a.Equal(400, res.Code)
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTBAT"})
+}
+
+func TestPriceFindings(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTQC", Name: "Test market"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Test quality"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTQC",
+		gin.H{"currencyCode": "EUR", "updatePrices": true, "prices": []gin.H{
+			{"date": "2021-04-01", "close": 10},
+			{"date": "2021-04-02", "close": 10},
+			{"date": "2021-04-05", "close": 10},
+			{"date": "2021-04-06", "close": 30},
+			{"date": "2021-04-07", "close": 15},
+			{"date": "2021-04-08", "close": 0},
+		}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	ratio := "2:1"
+	handlerConfig.DB.Create(&db.Event{
+		Date: model.Date(time.Date(2021, 4, 7, 0, 0, 0, 0, time.UTC)), Type: "split", Ratio: &ratio, SecurityUuid: securityUuid,
+	})
+
+	findings := func(query string) map[string]map[string]any {
+		body, res := jsonbody[gin.H](
+			api("GET", "/securities/maintenance/price-findings?securityUuid="+securityUuid+query, nil, &session.Token))
+		a.Equal(200, res.Code)
+		ret := map[string]map[string]any{}
+		for _, e := range body["entries"].([]any) {
+			f := e.(map[string]any)
+			ret[f["type"].(string)] = f
+		}
+		return ret
+	}
+
+	res = api("POST", "/securities/maintenance/price-findings", gin.H{"plateauDays": 3}, &session.Token)
+	a.Equal(200, res.Code)
+
+	found := findings("")
+	a.Len(found, 4)
+	a.Equal("2021-04-06", found["jump"]["date"])
+	a.Equal("close changed from 10 to 30 (+200.0%) without split", found["jump"]["details"])
+	a.Equal("2021-04-08", found["nonPositive"]["date"])
+	a.Equal("2021-04-08", found["stale"]["date"])
+	a.Equal("2021-04-01", found["plateau"]["date"])
+	a.Equal("close 10 unchanged for 3 prices until 2021-04-05", found["plateau"]["details"])
+
+	jumpID := strconv.Itoa(int(found["jump"]["id"].(float64)))
+	body, res = jsonbody[gin.H](
+		api("POST", "/securities/maintenance/price-findings/"+jumpID+"/acknowledgement",
+			gin.H{"note": "takeover bid"}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("testuser-e2e", body["acknowledgedBy"])
+	a.Equal("takeover bid", body["note"])
+	a.NotNil(body["acknowledgedAt"])
+
+	a.Len(findings("&acknowledged=true"), 1)
+	a.Len(findings("&acknowledged=false"), 3)
+
+	// Fixed data resolves open findings, acknowledged findings are kept
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTQC",
+		gin.H{"prices": []gin.H{{"date": "2021-04-08", "close": 15}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	body, res = jsonbody[gin.H](
+		api("POST", "/securities/maintenance/price-findings", gin.H{"plateauDays": 3}, &session.Token))
+	a.Equal(200, res.Code)
+	a.GreaterOrEqual(body["resolved"], 1.)
+
+	found = findings("")
+	a.Len(found, 3)
+	a.NotContains(found, "nonPositive")
+	a.Equal("takeover bid", found["jump"]["note"])
+
+	body, res = jsonbody[gin.H](
+		api("DELETE", "/securities/maintenance/price-findings/"+jumpID+"/acknowledgement", nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Nil(body["acknowledgedAt"])
+	a.Nil(body["acknowledgedBy"])
+
+	res = api("POST", "/securities/maintenance/price-findings/0/acknowledgement", nil, &session.Token)
+	a.Equal(404, res.Code)
+
+	res = api("POST", "/securities/maintenance/price-findings", gin.H{"staleDays": 0}, &session.Token)
+	a.Equal(400, res.Code)
+
+	res = api("GET", "/securities/maintenance/price-findings?type=foo", nil, &session.Token)
+	a.Equal(400, res.Code)
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTQC"})
+}
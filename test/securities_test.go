@@ -1,10 +1,18 @@
 package test
 
 import (
+	"bytes"
+	"image"
+	imagepng "image/png"
+	"mime/multipart"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/portfolio-report/pr-api/db"
 	"github.com/stretchr/testify/assert"
 )
@@ -239,3 +247,897 @@ func TestSecurities(t *testing.T) {
 
 	handlerConfig.DB.Delete(&db.Market{Code: "TEST"})
 }
+
+func TestSecurityIdentifiers(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	// Invalid identifiers are rejected
+	res := api("POST", "/securities/", gin.H{
+		"name":        "Identifier test",
+		"identifiers": []gin.H{{"scheme": "ticker", "value": "IDT"}},
+	}, &session.Token)
+	a.Equal(400, res.Code)
+
+	res = api("POST", "/securities/", gin.H{
+		"name":        "Identifier test",
+		"identifiers": []gin.H{{"scheme": "isin", "value": "DE0007164601"}},
+	}, &session.Token)
+	a.Equal(400, res.Code)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{
+			"name":       "Identifier test",
+			"symbolXnas": "idtx",
+			"identifiers": []gin.H{
+				{"scheme": "figi", "value": "bbg00idtest1"},
+				{"scheme": "ticker", "mic": "xlon", "value": "IDTL"},
+			},
+		}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+	a.Equal("IDTX", body["symbolXnas"])
+	a.Equal([]any{
+		map[string]any{"scheme": "figi", "mic": nil, "value": "BBG00IDTEST1"},
+		map[string]any{"scheme": "ticker", "mic": "XLON", "value": "IDTL"},
+		map[string]any{"scheme": "ticker", "mic": "XNAS", "value": "IDTX"},
+	}, body["identifiers"])
+
+	search := func(query string) []string {
+		body, res := jsonbody[[]gin.H](
+			api("GET", "/securities/search/"+query, nil, nil))
+		a.Equal(200, res.Code)
+		uuids := []string{}
+		for _, s := range body {
+			uuids = append(uuids, s["uuid"].(string))
+		}
+		return uuids
+	}
+	searchUuid := strings.Replace(securityUuid, "-", "", 4)
+	a.Contains(search("BBG00IDTEST1"), searchUuid)
+	a.Contains(search("idtl"), searchUuid)
+	a.Contains(search("IDTL@XLON"), searchUuid)
+	a.NotContains(search("IDTL@XNAS"), searchUuid)
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/?search=idtest", nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal(1., body["params"].(map[string]any)["totalCount"])
+
+	// ISIN and WKN are validated, WKN and CUSIP are derived from ISIN
+	res = api("PATCH", "/securities/"+securityUuid, gin.H{"isin": "US0378331006"}, &session.Token)
+	a.Equal(400, res.Code)
+	res = api("PATCH", "/securities/"+securityUuid, gin.H{"wkn": "ABCDEFG"}, &session.Token)
+	a.Equal(400, res.Code)
+
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"isin": "us0378331005"}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("US0378331005", body["isin"])
+	a.Contains(body["identifiers"], map[string]any{"scheme": "cusip", "mic": nil, "value": "037833100"})
+
+	// WKN and CUSIP of previous ISIN are replaced
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"isin": "DE0007164600", "wkn": nil}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("716460", body["wkn"])
+	a.NotContains(body["identifiers"], map[string]any{"scheme": "cusip", "mic": nil, "value": "037833100"})
+
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"isin": "DE000BASF111"}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("BASF11", body["wkn"])
+
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"name": "Identifier test"}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("DE000BASF111", body["isin"])
+	a.Equal("BASF11", body["wkn"])
+
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"isin": "", "wkn": "", "identifiers": []gin.H{
+			{"scheme": "figi", "value": "BBG00IDTEST1"},
+			{"scheme": "ticker", "mic": "XLON", "value": "IDTL"},
+			{"scheme": "ticker", "mic": "XNAS", "value": "IDTX"},
+		}}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Nil(body["isin"])
+	a.Nil(body["wkn"])
+
+	// Removing legacy symbol keeps other identifiers
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"symbolXnas": ""}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Nil(body["symbolXnas"])
+	a.Len(body["identifiers"], 2)
+
+	// Identifiers are part of history
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+securityUuid+"/history", nil, &session.Token))
+	a.Equal(200, res.Code)
+	previous := body["entries"].([]any)[0].(map[string]any)["previous"].(map[string]any)
+	a.Len(previous["identifiers"], 3)
+	a.Len(body["entries"], 6)
+
+	// Legacy invalid ISIN and WKN are kept unless changed
+	handlerConfig.DB.Model(&db.Security{}).Where("uuid = ?", securityUuid).
+		Updates(map[string]any{"isin": "DE0007164601", "wkn": "71646O"})
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"name": "Identifier legacy"}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("Identifier legacy", body["name"])
+	a.Equal("DE0007164601", body["isin"])
+	a.Equal("71646O", body["wkn"])
+	res = api("PATCH", "/securities/"+securityUuid, gin.H{"isin": "DE0007164602"}, &session.Token)
+	a.Equal(400, res.Code)
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"isin": "", "wkn": ""}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Nil(body["isin"])
+	a.Nil(body["wkn"])
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+}
+
+func TestSearchSecuritiesFaceted(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTFCT", Name: "Test market"})
+
+	a := assert.New(t)
+
+	var securityUuids []string
+	for _, name := range []string{"Facet Gamma", "Facet Alpha", "Facet Beta"} {
+		reqBody := gin.H{"name": name, "securityType": "test-facets"}
+		if name == "Facet Alpha" {
+			reqBody["isin"] = "XS0000000009"
+		}
+		body, res := jsonbody[gin.H](api("POST", "/securities/", reqBody, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuids = append(securityUuids, body["uuid"].(string))
+	}
+	for _, securityUuid := range securityUuids[:2] {
+		res := api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTFCT",
+			gin.H{"currencyCode": "USD"}, &session.Token)
+		a.Equal(200, res.Code)
+	}
+	res := api("PUT", "/tags/test-facets",
+		gin.H{"securities": []gin.H{{"uuid": securityUuids[0]}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	search := func(query string) ([]string, map[string]any, map[string]any) {
+		body, res := jsonbody[gin.H](api("GET", "/securities/search?"+query, nil, nil))
+		a.Equal(200, res.Code)
+		names := []string{}
+		for _, e := range body["entries"].([]any) {
+			names = append(names, e.(map[string]any)["name"].(string))
+		}
+		return names, body["params"].(map[string]any), body["facets"].(map[string]any)
+	}
+
+	// Pages are sorted by name
+	names, params, facets := search("q=type:test-facets&limit=2")
+	a.Equal([]string{"Facet Alpha", "Facet Beta"}, names)
+	a.Equal(3., params["totalCount"])
+	a.NotNil(params["nextCursor"])
+	a.Equal([]any{map[string]any{"value": "test-facets", "count": 3.}}, facets["securityType"])
+	a.Equal([]any{map[string]any{"value": "TESTFCT", "count": 2.}}, facets["market"])
+	a.Equal([]any{map[string]any{"value": "test-facets", "count": 1.}}, facets["tag"])
+
+	names, params, _ = search("q=type:test-facets&limit=2&cursor=" + params["nextCursor"].(string))
+	a.Equal([]string{"Facet Gamma"}, names)
+	a.Nil(params["nextCursor"])
+
+	// Filters
+	names, _, _ = search("q=type:test-facets+market:testfct+currency:usd")
+	a.Equal([]string{"Facet Alpha", "Facet Gamma"}, names)
+	names, _, _ = search("q=type:test-facets+tag:test-facets")
+	a.Equal([]string{"Facet Gamma"}, names)
+
+	// Tags must all match
+	res = api("PUT", "/tags/test-facets-2",
+		gin.H{"securities": []gin.H{{"uuid": securityUuids[0]}, {"uuid": securityUuids[1]}}}, &session.Token)
+	a.Equal(200, res.Code)
+	names, _, _ = search("q=type:test-facets+tag:test-facets-2")
+	a.Len(names, 2)
+	names, _, _ = search("q=type:test-facets+tag:test-facets+tag:test-facets-2")
+	a.Equal([]string{"Facet Gamma"}, names)
+	legacy, res := jsonbody[[]gin.H](
+		api("GET", "/securities/search/tag:test-facets%20tag:test-facets-2?securityType=test-facets", nil, nil))
+	a.Equal(200, res.Code)
+	a.Len(legacy, 1)
+	res = api("DELETE", "/tags/test-facets-2", nil, &session.Token)
+	a.Equal(204, res.Code)
+	names, _, _ = search("q=type:test-facets+isin:xs0000000009")
+	a.Equal([]string{"Facet Alpha"}, names)
+	names, _, _ = search("q=market:testfct+currency:eur")
+	a.Empty(names)
+
+	res = api("GET", "/securities/search?q=facet&cursor=foo", nil, nil)
+	a.Equal(400, res.Code)
+	res = api("GET", "/securities/search?q=facet&limit=101", nil, nil)
+	a.Equal(400, res.Code)
+
+	for _, securityUuid := range securityUuids {
+		res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+	res = api("DELETE", "/tags/test-facets", nil, &session.Token)
+	a.Equal(204, res.Code)
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTFCT"})
+}
+
+func TestSearchSecuritiesRanking(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	securityUuids := []string{}
+	for _, reqBody := range []gin.H{
+		{"name": "Rankingtest Ticker", "isin": "US5949181045", "symbolXnas": "RKTQ"},
+		{"name": "RKTQ Fund"},
+		{"name": "Société Générale Rankingtest"},
+		{"name": "Rankingtest Alpha"},
+		{"name": "Rankingtest Alpha Beta Gamma"},
+	} {
+		reqBody["securityType"] = "test-ranking"
+		body, res := jsonbody[gin.H](api("POST", "/securities/", reqBody, &session.Token))
+		a.Equal(201, res.Code)
+		securityUuids = append(securityUuids, body["uuid"].(string))
+	}
+
+	search := func(query string) []string {
+		body, res := jsonbody[gin.H](
+			api("GET", "/securities/search?securityType=test-ranking&q="+url.QueryEscape(query), nil, nil))
+		a.Equal(200, res.Code)
+		names := []string{}
+		for _, e := range body["entries"].([]any) {
+			names = append(names, e.(map[string]any)["name"].(string))
+		}
+		return names
+	}
+
+	// Prefix of ISIN and identifiers, but not of single character
+	a.Equal([]string{"Rankingtest Ticker"}, search("us594918"))
+	a.Equal("Rankingtest Ticker", search("RKT")[0])
+	a.Empty(search("U"))
+
+	// Exact identifier before similar name
+	a.Equal([]string{"Rankingtest Ticker", "RKTQ Fund"}, search("rktq"))
+
+	// Names match independent of accents and case, most similar first
+	a.Equal("Société Générale Rankingtest", search("societe generale rankingtest")[0])
+	a.Equal("Société Générale Rankingtest", search("SOCIETE GÉNÉRALE")[0])
+	a.Equal([]string{"Rankingtest Alpha", "Rankingtest Alpha Beta Gamma"}, search("rankingtest alpha")[:2])
+
+	for _, securityUuid := range securityUuids {
+		res := api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+}
+
+func TestSecurityAliases(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](api("POST", "/securities/", gin.H{
+		"name":         "Aliastest Platforms",
+		"securityType": "test-aliases",
+		"aliases": []gin.H{
+			{"kind": "former", "name": " Aliasbook   Inc. "},
+			{"kind": "abbreviation", "name": "ALBK"},
+			{"kind": "", "name": "Aliasbuch"},
+			{"kind": "former", "name": "aliasbook inc."},
+		},
+	}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	// Aliases are normalized and sorted by kind and name
+	aliases := []any{
+		map[string]any{"kind": "abbreviation", "name": "ALBK"},
+		map[string]any{"kind": "former", "name": "Aliasbook Inc."},
+		map[string]any{"kind": "other", "name": "Aliasbuch"},
+	}
+	a.Equal(aliases, body["aliases"])
+
+	res = api("POST", "/securities/", gin.H{"name": "Invalid", "aliases": []gin.H{{"kind": "nickname", "name": "X"}}}, &session.Token)
+	a.Equal(400, res.Code)
+
+	// Aliases are kept if not given
+	body, res = jsonbody[gin.H](api("PATCH", "/securities/"+securityUuid, gin.H{"wkn": "A1JWVX"}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal(aliases, body["aliases"])
+
+	body, res = jsonbody[gin.H](api("GET", "/securities/uuid/"+securityUuid, nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal(aliases, body["aliases"])
+
+	search := func(query string) []string {
+		body, res := jsonbody[gin.H](
+			api("GET", "/securities/search?securityType=test-aliases&q="+url.QueryEscape(query), nil, nil))
+		a.Equal(200, res.Code)
+		uuids := []string{}
+		for _, e := range body["entries"].([]any) {
+			uuids = append(uuids, e.(map[string]any)["uuid"].(string))
+		}
+		return uuids
+	}
+	searchUuid := strings.Replace(securityUuid, "-", "", 4)
+
+	// Former names, abbreviations and words of names are found
+	a.Equal([]string{searchUuid}, search("aliasbook"))
+	a.Equal([]string{searchUuid}, search("albk"))
+	a.Equal([]string{searchUuid}, search("Aliasbok Inc"))
+	a.Equal([]string{searchUuid}, search("platf"))
+
+	// Aliases are part of history
+	body, res = jsonbody[gin.H](
+		api("PATCH", "/securities/"+securityUuid, gin.H{"aliases": []gin.H{}}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal([]any{}, body["aliases"])
+	a.Empty(search("albk"))
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+securityUuid+"/history", nil, &session.Token))
+	a.Equal(200, res.Code)
+	previous := body["entries"].([]any)[0].(map[string]any)["previous"].(map[string]any)
+	a.Equal(aliases, previous["aliases"])
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+}
+
+// multipartFile returns multipart body with file and its content type
+func multipartFile(filename string, content []byte) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		panic(err)
+	}
+	part.Write(content)
+	writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+func TestSecurityLogo(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](api("POST", "/securities/", gin.H{"name": "Logo Security"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	var png bytes.Buffer
+	a.Nil(imagepng.Encode(&png, image.NewRGBA(image.Rect(0, 0, 1000, 500))))
+
+	// Format is detected by content, not by file name
+	form, contentType := multipartFile("logo.png", []byte("<html><script>alert(1)</script></html>"))
+	res = apiRaw("POST", "/securities/uuid/"+securityUuid+"/logo", contentType, form, &session.Token)
+	a.Equal(400, res.Code)
+
+	form, contentType = multipartFile("logo.gif", png.Bytes())
+	body, res = jsonbody[gin.H](apiRaw("POST", "/securities/uuid/"+securityUuid+"/logo", contentType, form, &session.Token))
+	a.Equal(200, res.Code)
+	logoUrl := body["logoUrl"].(string)
+	logoThumbUrl := body["logoThumbUrl"].(string)
+	a.Regexp("^/logos/[0-9a-f-]{36}\\.png$", logoUrl)
+	a.Regexp("^/logos/[0-9a-f-]{36}_thumb\\.png$", logoThumbUrl)
+
+	// Logo and thumbnail are scaled and served by API
+	for url, size := range map[string]image.Point{logoUrl: {512, 256}, logoThumbUrl: {64, 32}} {
+		res = api("GET", url, nil, nil)
+		a.Equal(200, res.Code)
+		a.Equal("image/png", res.Header().Get("Content-Type"))
+		a.Contains(res.Header().Get("Cache-Control"), "immutable")
+		config, err := imagepng.DecodeConfig(res.Body)
+		a.Nil(err)
+		a.Equal(size, image.Point{config.Width, config.Height})
+	}
+
+	// Missing logos may appear later and are not cached
+	res = api("GET", "/logos/"+uuid.NewString()+".png", nil, nil)
+	a.Equal(404, res.Code)
+	a.NotContains(res.Header().Get("Cache-Control"), "immutable")
+
+	body, res = jsonbody[gin.H](api("GET", "/securities/"+securityUuid, nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal(logoUrl, body["logoUrl"])
+	a.Equal(logoThumbUrl, body["logoThumbUrl"])
+
+	// SVG is sanitized
+	form, contentType = multipartFile("logo.svg",
+		[]byte(`<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"><script>alert(2)</script><rect/></svg>`))
+	body, res = jsonbody[gin.H](apiRaw("POST", "/securities/uuid/"+securityUuid+"/logo", contentType, form, &session.Token))
+	a.Equal(200, res.Code)
+	res = api("GET", body["logoUrl"].(string), nil, nil)
+	a.Equal(200, res.Code)
+	a.Equal("image/svg+xml", res.Header().Get("Content-Type"))
+	a.NotContains(res.Body.String(), "alert")
+
+	res = api("DELETE", "/securities/uuid/"+securityUuid+"/logo", nil, &session.Token)
+	a.Equal(204, res.Code)
+	body, res = jsonbody[gin.H](api("GET", "/securities/"+securityUuid, nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Nil(body["logoUrl"])
+	a.Nil(body["logoThumbUrl"])
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+}
+
+func TestSecurityHoldings(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](api("POST", "/securities/", gin.H{"name": "Holdings Fund"}, &session.Token))
+	a.Equal(201, res.Code)
+	fundUuid := body["uuid"].(string)
+	body, res = jsonbody[gin.H](api("POST", "/securities/",
+		gin.H{"name": "Holdings Constituent", "isin": "DE0007164600"}, &session.Token))
+	a.Equal(201, res.Code)
+	constituentUuid := body["uuid"].(string)
+
+	body, res = jsonbody[gin.H](api("GET", "/securities/uuid/"+fundUuid+"/holdings", nil, nil))
+	a.Equal(200, res.Code)
+	a.Nil(body["asOf"])
+	a.Equal([]any{}, body["entries"])
+
+	put := func(query, contentType, data string) *httptest.ResponseRecorder {
+		return apiRaw("PUT", "/securities/uuid/"+fundUuid+"/holdings"+query, contentType,
+			strings.NewReader(data), &session.Token)
+	}
+
+	// Constituents are linked by ISIN, name is taken from master data
+	csv := "isin,name,weight\n" +
+		"de0007164600,,45.5\n" +
+		",Cash,4.5\n"
+	res = put("", "text/csv", csv)
+	a.Equal(400, res.Code)
+	res = put("?asOf=2022-03-31", "text/csv", csv)
+	a.Equal(200, res.Code)
+
+	body, res = jsonbody[gin.H](api("GET", "/securities/uuid/"+fundUuid+"/holdings", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal("2022-03-31", body["asOf"])
+	a.Equal([]any{
+		map[string]any{"securityUuid": constituentUuid, "name": "Holdings Constituent", "isin": "DE0007164600", "weight": "45.5"},
+		map[string]any{"securityUuid": nil, "name": "Cash", "isin": nil, "weight": "4.5"},
+	}, body["entries"])
+
+	// Invalid uploads are rejected as a whole
+	for _, invalid := range []string{
+		"name\nCash\n",
+		"name,weight\nCash,0\n",
+		"name,weight\nA,60\nB,50\n",
+		"isin,weight\nDE0007164601,10\n",
+		"weight\n10\n",
+		"securityUuid,weight\n" + fundUuid + ",10\n",
+		"securityUuid,weight\n" + constituentUuid + ",10\n" + constituentUuid + ",20\n",
+	} {
+		res = put("?asOf=2022-04-30", "text/csv", invalid)
+		a.Equal(400, res.Code, invalid)
+	}
+	body, res = jsonbody[gin.H](api("GET", "/securities/uuid/"+fundUuid+"/holdings", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal("2022-03-31", body["asOf"])
+
+	res = put("", "text/plain", "")
+	a.Equal(400, res.Code)
+	res = put("?asOf=2022-02-31", "text/csv", "name,weight\nCash,100\n")
+	a.Equal(400, res.Code)
+	res = put("?asOf=2022-04-30", "text/csv", "name,weight\n"+strings.Repeat("Cash,0.1\n", 2<<20))
+	a.Equal(400, res.Code)
+	res = apiRaw("PUT", "/securities/uuid/"+uuid.NewString()+"/holdings", "application/json",
+		strings.NewReader(`{"asOf":"2022-03-31","holdings":[]}`), &session.Token)
+	a.Equal(404, res.Code)
+	res = api("GET", "/securities/uuid/"+uuid.NewString()+"/holdings", nil, nil)
+	a.Equal(404, res.Code)
+
+	// Empty upload removes holdings
+	body, res = jsonbody[gin.H](put("", "application/json", `{"asOf":"2022-04-30","holdings":[]}`))
+	a.Equal(200, res.Code)
+	a.Equal([]any{}, body["entries"])
+
+	for _, securityUuid := range []string{fundUuid, constituentUuid} {
+		res := api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+		a.Equal(200, res.Code)
+	}
+}
+
+func TestSecurityCacheInvalidation(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TEST", Name: "Test market"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](api("POST", "/securities/", gin.H{"name": "Cached name"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TEST",
+		gin.H{"currencyCode": "EUR", "prices": []gin.H{{"date": "2022-01-03", "close": "1"}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	getName := func() any {
+		body, res := jsonbody[gin.H](api("GET", "/securities/uuid/"+securityUuid, nil, nil))
+		a.Equal(200, res.Code)
+		return body["name"]
+	}
+	getLastPriceDate := func() any {
+		body, res := jsonbody[gin.H](api("GET", "/securities/uuid/"+securityUuid+"/markets/TEST", nil, nil))
+		a.Equal(200, res.Code)
+		return body["lastPriceDate"]
+	}
+
+	a.Equal("Cached name", getName())
+	a.Equal("2022-01-03", getLastPriceDate())
+
+	// Changes of security and prices invalidate cached responses
+	res = api("PATCH", "/securities/"+securityUuid, gin.H{"name": "Changed name"}, &session.Token)
+	a.Equal(200, res.Code)
+	a.Equal("Changed name", getName())
+
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TEST",
+		gin.H{"prices": []gin.H{{"date": "2022-01-04", "close": "2"}}}, &session.Token)
+	a.Equal(200, res.Code)
+	a.Equal("2022-01-04", getLastPriceDate())
+
+	// Changes outside of API are visible after purge
+	handlerConfig.DB.Model(&db.Security{}).Where("uuid = ?", securityUuid).Update("name", "Name in database")
+	a.Equal("Changed name", getName())
+	res = api("DELETE", "/securities/maintenance/cache?securityUuid=invalid", nil, &session.Token)
+	a.Equal(400, res.Code)
+	res = api("DELETE", "/securities/maintenance/cache?securityUuid="+securityUuid, nil, &session.Token)
+	a.Equal(204, res.Code)
+	a.Equal("Name in database", getName())
+
+	handlerConfig.DB.Model(&db.Security{}).Where("uuid = ?", securityUuid).Update("name", "Purged name")
+	res = api("DELETE", "/securities/maintenance/cache", nil, &session.Token)
+	a.Equal(204, res.Code)
+	a.Equal("Purged name", getName())
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+}
+
+func TestConditionalRequests(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TEST", Name: "Test market"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](api("POST", "/securities/", gin.H{"name": "Conditional"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TEST",
+		gin.H{"currencyCode": "EUR", "prices": []gin.H{{"date": "2022-01-03", "close": "1"}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	get := func(target string, header, value string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", target, nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		res := httptest.NewRecorder()
+		app.ServeHTTP(res, req)
+		return res
+	}
+
+	for _, target := range []string{
+		"/securities/uuid/" + securityUuid,
+		"/securities/uuid/" + securityUuid + "/markets/TEST?from=2022-01-01",
+		"/currencies/",
+	} {
+		res := get(target, "", "")
+		a.Equal(200, res.Code, target)
+		etag := res.Header().Get("ETag")
+		lastModified := res.Header().Get("Last-Modified")
+		a.Regexp(`^"[0-9a-f]+"$`, etag, target)
+		a.NotEmpty(lastModified, target)
+
+		res = get(target, "If-None-Match", etag)
+		a.Equal(304, res.Code, target)
+		a.Empty(res.Body.String(), target)
+		a.Equal(etag, res.Header().Get("ETag"), target)
+
+		res = get(target, "If-Modified-Since", lastModified)
+		a.Equal(304, res.Code, target)
+
+		res = get(target, "If-None-Match", `"other"`)
+		a.Equal(200, res.Code, target)
+		a.Equal(etag, res.Header().Get("ETag"), target)
+	}
+
+	etagOf := func(target string) string {
+		res := get(target, "", "")
+		a.Equal(200, res.Code)
+		return res.Header().Get("ETag")
+	}
+
+	// Changes of security, events and prices change ETags
+	securityEtag := etagOf("/securities/uuid/" + securityUuid)
+	pricesEtag := etagOf("/securities/uuid/" + securityUuid + "/markets/TEST?from=2022-01-01")
+
+	res = api("PATCH", "/securities/"+securityUuid, gin.H{"name": "Conditional changed"}, &session.Token)
+	a.Equal(200, res.Code)
+	res = get("/securities/uuid/"+securityUuid, "If-None-Match", securityEtag)
+	a.Equal(200, res.Code)
+	body, _ = jsonbody[gin.H](res)
+	a.Equal("Conditional changed", body["name"])
+	securityEtag = res.Header().Get("ETag")
+
+	res = api("POST", "/securities/uuid/"+securityUuid+"/events",
+		gin.H{"date": "2022-01-03", "type": "split", "ratio": "2:1"}, &session.Token)
+	a.Equal(201, res.Code)
+	a.NotEqual(securityEtag, etagOf("/securities/uuid/"+securityUuid))
+	a.NotEqual(pricesEtag, etagOf("/securities/uuid/"+securityUuid+"/markets/TEST?from=2022-01-01"))
+	securityEtag = etagOf("/securities/uuid/" + securityUuid)
+	pricesEtag = etagOf("/securities/uuid/" + securityUuid + "/markets/TEST?from=2022-01-01")
+
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TEST",
+		gin.H{"prices": []gin.H{{"date": "2022-01-04", "close": "2"}}}, &session.Token)
+	a.Equal(200, res.Code)
+	res = get("/securities/uuid/"+securityUuid+"/markets/TEST?from=2022-01-01", "If-None-Match", pricesEtag)
+	a.Equal(200, res.Code)
+	body, _ = jsonbody[gin.H](res)
+	a.Equal("2022-01-04", body["lastPriceDate"])
+	a.NotEqual(securityEtag, etagOf("/securities/uuid/"+securityUuid))
+
+	// Unknown securities are not found regardless of validators
+	res = get("/securities/uuid/"+uuid.NewString(), "If-None-Match", "*")
+	a.Equal(404, res.Code)
+	res = get("/securities/uuid/"+securityUuid+"/markets/XXX", "If-None-Match", "*")
+	a.Equal(404, res.Code)
+
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+}
+
+func TestSecurityHistory(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&db.Market{Code: "TESTHIS", Name: "Test market"})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "History v1"}, &session.Token))
+	a.Equal(201, res.Code)
+	securityUuid := body["uuid"].(string)
+
+	res = api("PATCH", "/securities/"+securityUuid, gin.H{"name": "History v2"}, &session.Token)
+	a.Equal(200, res.Code)
+
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTHIS",
+		gin.H{"currencyCode": "EUR"}, &session.Token)
+	a.Equal(200, res.Code)
+
+	// Prices are not part of history
+	res = api("PATCH", "/securities/uuid/"+securityUuid+"/markets/TESTHIS",
+		gin.H{"prices": []gin.H{{"date": "2021-05-03", "close": 1}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	res = api("PUT", "/tags/test-history",
+		gin.H{"securities": []gin.H{{"uuid": securityUuid}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	history := func() []any {
+		body, res := jsonbody[gin.H](
+			api("GET", "/securities/uuid/"+securityUuid+"/history", nil, &session.Token))
+		a.Equal(200, res.Code)
+		return body["entries"].([]any)
+	}
+
+	entries := history()
+	a.Len(entries, 4)
+	actions := []any{}
+	for _, e := range entries {
+		actions = append(actions, e.(map[string]any)["action"])
+	}
+	a.Equal([]any{"updateTag", "updateMarket", "update", "create"}, actions)
+
+	create := entries[3].(map[string]any)
+	a.Equal(1., create["version"])
+	a.Nil(create["previous"])
+	a.Equal("testuser-e2e", create["changedBy"])
+
+	update := entries[2].(map[string]any)
+	a.Equal("History v1", update["previous"].(map[string]any)["name"])
+
+	// Revert name, market and tag
+	body, res = jsonbody[gin.H](
+		api("POST", "/securities/uuid/"+securityUuid+"/history/2/revert", nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("History v1", body["name"])
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/"+securityUuid, nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Len(body["markets"], 0)
+
+	body, res = jsonbody[gin.H](api("GET", "/tags/test-history", nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Len(body["securities"], 0)
+
+	entries = history()
+	a.Len(entries, 5)
+	revert := entries[0].(map[string]any)
+	a.Equal("revert", revert["action"])
+	previous := revert["previous"].(map[string]any)
+	a.Equal("History v2", previous["name"])
+	a.Len(previous["markets"], 1)
+	a.Equal([]any{"test-history"}, previous["tags"])
+
+	// History is kept after deletion and deletion can be reverted
+	res = api("DELETE", "/securities/"+securityUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	entries = history()
+	a.Len(entries, 6)
+	a.Equal("delete", entries[0].(map[string]any)["action"])
+
+	body, res = jsonbody[gin.H](
+		api("POST", "/securities/uuid/"+securityUuid+"/history/6/revert", nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("History v1", body["name"])
+
+	res = api("GET", "/securities/uuid/"+securityUuid, nil, nil)
+	a.Equal(200, res.Code)
+
+	// Revert creation removes security
+	res = api("POST", "/securities/uuid/"+securityUuid+"/history/1/revert", nil, &session.Token)
+	a.Equal(204, res.Code)
+
+	res = api("GET", "/securities/uuid/"+securityUuid, nil, nil)
+	a.Equal(404, res.Code)
+
+	res = api("POST", "/securities/uuid/"+securityUuid+"/history/42/revert", nil, &session.Token)
+	a.Equal(404, res.Code)
+
+	res = api("GET", "/securities/uuid/952df501-1e22-4693-a208-0c013cb1b415/history", nil, &session.Token)
+	a.Equal(404, res.Code)
+
+	res = api("DELETE", "/tags/test-history", nil, &session.Token)
+	a.Equal(204, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{Code: "TESTHIS"})
+}
+
+func TestMergeSecurities(t *testing.T) {
+	handlerConfig.DB.Model(&db.User{}).Where("username = 'testuser-e2e'").Update("is_admin", true)
+	handlerConfig.DB.Create(&[]db.Market{
+		{Code: "TESTMRG1", Name: "Test market"},
+		{Code: "TESTMRG2", Name: "Test market"},
+	})
+
+	a := assert.New(t)
+
+	body, res := jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Merge canonical", "isin": "GB00BH4HKS39"}, &session.Token))
+	a.Equal(201, res.Code)
+	canonicalUuid := body["uuid"].(string)
+
+	body, res = jsonbody[gin.H](
+		api("POST", "/securities/", gin.H{"name": "Merge duplicate", "isin": "DE000A0F5UF5", "wkn": "MRG001"}, &session.Token))
+	a.Equal(201, res.Code)
+	duplicateUuid := body["uuid"].(string)
+
+	res = api("PATCH", "/securities/uuid/"+canonicalUuid+"/markets/TESTMRG1",
+		gin.H{"currencyCode": "EUR", "prices": []gin.H{
+			{"date": "2021-06-01", "close": 1},
+			{"date": "2021-06-02", "close": 2},
+		}}, &session.Token)
+	a.Equal(200, res.Code)
+	res = api("PATCH", "/securities/uuid/"+duplicateUuid+"/markets/TESTMRG1",
+		gin.H{"currencyCode": "EUR", "symbol": "MRG", "prices": []gin.H{
+			{"date": "2021-06-02", "close": 20},
+			{"date": "2021-06-03", "close": 3},
+		}}, &session.Token)
+	a.Equal(200, res.Code)
+	res = api("PATCH", "/securities/uuid/"+duplicateUuid+"/markets/TESTMRG2",
+		gin.H{"currencyCode": "EUR", "prices": []gin.H{{"date": "2021-06-01", "close": 5}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	res = api("POST", "/securities/uuid/"+duplicateUuid+"/events",
+		gin.H{"date": "2021-06-02", "type": "dividend", "amount": "0.5", "currencyCode": "EUR"}, &session.Token)
+	a.Equal(201, res.Code)
+
+	res = api("PUT", "/tags/test-merge",
+		gin.H{"securities": []gin.H{{"uuid": duplicateUuid}}}, &session.Token)
+	a.Equal(200, res.Code)
+
+	body, res = jsonbody[gin.H](
+		api("POST", "/portfolios/", gin.H{"name": "Merge", "note": "", "baseCurrencyCode": "EUR"}, &session.Token))
+	a.Equal(201, res.Code)
+	portfolioId := strconv.Itoa(int(body["id"].(float64)))
+	res = api("PUT", "/portfolios/"+portfolioId+"/securities/"+uuid.New().String(), gin.H{
+		"name":         "Merge duplicate",
+		"currencyCode": "EUR",
+		"securityUuid": duplicateUuid,
+		"active":       true,
+		"updatedAt":    "2022-01-31T11:11:11Z",
+		"events":       []any{},
+	}, &session.Token)
+	a.Equal(200, res.Code)
+
+	res = api("POST", "/securities/uuid/"+canonicalUuid+"/merge",
+		gin.H{"duplicateUuid": canonicalUuid}, &session.Token)
+	a.Equal(400, res.Code)
+	res = api("POST", "/securities/uuid/"+canonicalUuid+"/merge",
+		gin.H{"duplicateUuid": duplicateUuid, "prefer": "foo"}, &session.Token)
+	a.Equal(400, res.Code)
+
+	body, res = jsonbody[gin.H](
+		api("POST", "/securities/uuid/"+canonicalUuid+"/merge",
+			gin.H{"duplicateUuid": duplicateUuid, "prefer": "duplicate"}, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal("Merge canonical", body["name"])
+	a.Equal("GB00BH4HKS39", body["isin"])
+	a.Equal("MRG001", body["wkn"])
+
+	// ISIN of duplicate remains searchable as identifier
+	a.Contains(body["identifiers"], map[string]any{"scheme": "isin", "mic": nil, "value": "DE000A0F5UF5"})
+	results, res := jsonbody[[]gin.H](api("GET", "/securities/search/DE000A0F5UF5", nil, nil))
+	a.Equal(200, res.Code)
+	a.Len(results, 1)
+	a.Equal(strings.ReplaceAll(canonicalUuid, "-", ""), results[0]["uuid"])
+
+	// Old UUID returns canonical security
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+duplicateUuid, nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal(strings.ReplaceAll(canonicalUuid, "-", ""), body["uuid"])
+	a.Len(body["markets"], 2)
+	a.Len(body["events"], 1)
+	a.Equal([]any{"test-merge"}, body["tags"])
+
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+canonicalUuid+"/markets/TESTMRG1?from=2021-06-01", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal("MRG", body["symbol"])
+	closes := []any{}
+	for _, p := range body["prices"].([]any) {
+		closes = append(closes, p.(map[string]any)["close"])
+	}
+	a.Equal([]any{1., 20., 3.}, closes)
+	a.Equal("2021-06-03", body["lastPriceDate"])
+
+	// Prices are available by old UUID, single and in batch
+	body, res = jsonbody[gin.H](
+		api("GET", "/securities/uuid/"+duplicateUuid+"/markets/TESTMRG1?from=2021-06-01", nil, nil))
+	a.Equal(200, res.Code)
+	a.Equal("2021-06-03", body["lastPriceDate"])
+	a.NotEmpty(res.Header().Get("ETag"))
+
+	batch, res := jsonbody[[]gin.H](api("POST", "/securities/prices/batch", gin.H{"series": []gin.H{
+		{"uuid": duplicateUuid, "marketCode": "TESTMRG1", "from": "2021-06-01"},
+	}}, nil))
+	a.Equal(200, res.Code)
+	a.Equal(200., batch[0]["status"])
+	a.Equal("2021-06-03", batch[0]["series"].(map[string]any)["lastPriceDate"])
+
+	portfolioSecurities, res := jsonbody[[]gin.H](
+		api("GET", "/portfolios/"+portfolioId+"/securities/", nil, &session.Token))
+	a.Equal(200, res.Code)
+	a.Equal(canonicalUuid, portfolioSecurities[0]["securityUuid"])
+
+	res = api("GET", "/securities/"+duplicateUuid, nil, &session.Token)
+	a.Equal(404, res.Code)
+
+	res = api("POST", "/securities/uuid/"+canonicalUuid+"/merge",
+		gin.H{"duplicateUuid": duplicateUuid}, &session.Token)
+	a.Equal(404, res.Code)
+
+	res = api("DELETE", "/portfolios/"+portfolioId, nil, &session.Token)
+	a.Equal(200, res.Code)
+	res = api("DELETE", "/tags/test-merge", nil, &session.Token)
+	a.Equal(204, res.Code)
+	res = api("DELETE", "/securities/"+canonicalUuid, nil, &session.Token)
+	a.Equal(200, res.Code)
+
+	handlerConfig.DB.Delete(&db.Market{}, "code IN ?", []string{"TESTMRG1", "TESTMRG2"})
+}